@@ -4,25 +4,41 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
+	"strings"
 	"time"
 )
 
+// DefaultBaseURL is the StrawPoll API v3 endpoint used by NewClient.
+const DefaultBaseURL = "https://api.strawpoll.com/v3"
+
 const (
-	defaultBaseURL = "https://api.strawpoll.com/v3"
 	defaultTimeout = 30 * time.Second
 	defaultRate    = 10
 	rateInterval   = time.Second
 )
 
+// ErrDryRun is returned by mutating requests when the client is in dry-run mode.
+var ErrDryRun = errors.New("dry run: request not sent")
+
+// DryRunRequest describes a request that would have been sent in dry-run mode.
+type DryRunRequest struct {
+	Method string `json:"method"`
+	URL    string `json:"url"`
+	Path   string `json:"path"`
+	Body   any    `json:"body,omitempty"`
+}
+
 // Client is the StrawPoll API client.
 type Client struct {
 	httpClient  *http.Client
 	rateLimiter *RateLimiter
 	apiKey      string
 	baseURL     string
+	dryRun      func(*DryRunRequest) error
 }
 
 // NewClient creates a Client with retry transport, rate limiter, and auth.
@@ -34,17 +50,43 @@ func NewClient(apiKey string) *Client {
 		},
 		rateLimiter: NewRateLimiter(defaultRate, rateInterval),
 		apiKey:      apiKey,
-		baseURL:     defaultBaseURL,
+		baseURL:     DefaultBaseURL,
 	}
 }
 
+// BaseURL returns the API endpoint requests are sent to.
+func (c *Client) BaseURL() string {
+	return c.baseURL
+}
+
+// SetBaseURL points the client at another API endpoint, such as a proxy or
+// a test server.
+func (c *Client) SetBaseURL(u string) {
+	c.baseURL = strings.TrimRight(u, "/")
+}
+
 // Close releases resources held by the client.
 func (c *Client) Close() {
 	c.rateLimiter.Close()
 }
 
+// SetDryRun switches the client to dry-run mode: mutating requests are passed
+// to fn instead of being sent, and then fail with ErrDryRun.
+// GET requests are still sent, since some commands read before they write.
+func (c *Client) SetDryRun(fn func(*DryRunRequest) error) {
+	c.dryRun = fn
+}
+
 // do executes an API request with rate limiting, auth, and error handling.
 func (c *Client) do(ctx context.Context, method, path string, body any, out any) error {
+	if c.dryRun != nil && method != http.MethodGet {
+		if err := c.dryRun(&DryRunRequest{Method: method, URL: c.baseURL + path, Path: path, Body: body}); err != nil {
+			return err
+		}
+
+		return ErrDryRun
+	}
+
 	if err := c.rateLimiter.Wait(ctx); err != nil {
 		return fmt.Errorf("rate limiter: %w", err)
 	}
@@ -165,3 +165,46 @@ func TestClient_Put(t *testing.T) {
 		t.Fatalf("expected updated=true, got %s", out["updated"])
 	}
 }
+
+func TestClient_DryRun(t *testing.T) {
+	var hits []string
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		hits = append(hits, r.Method)
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]string{"id": "abc123"})
+	}))
+	defer srv.Close()
+
+	c := newTestClient(srv)
+	defer c.Close()
+
+	var got *DryRunRequest
+	c.SetDryRun(func(req *DryRunRequest) error {
+		got = req
+		return nil
+	})
+
+	err := c.Post(context.Background(), "/polls", map[string]string{"title": "Test"}, nil)
+	if !errors.Is(err, ErrDryRun) {
+		t.Fatalf("Post error = %v, want ErrDryRun", err)
+	}
+
+	if got == nil || got.Method != http.MethodPost || got.Path != "/polls" || got.URL != srv.URL+"/polls" {
+		t.Fatalf("dry-run request = %+v", got)
+	}
+
+	if err := c.Delete(context.Background(), "/polls/abc123"); !errors.Is(err, ErrDryRun) {
+		t.Fatalf("Delete error = %v, want ErrDryRun", err)
+	}
+
+	// Reads still go through so commands can build their request bodies.
+	var out map[string]string
+	if err := c.Get(context.Background(), "/polls/abc123", &out); err != nil {
+		t.Fatalf("Get error: %v", err)
+	}
+
+	if len(hits) != 1 || hits[0] != http.MethodGet {
+		t.Errorf("server saw %v, want only GET", hits)
+	}
+}
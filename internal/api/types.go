@@ -35,6 +35,14 @@ const (
 	DupcheckNone    = "none"
 )
 
+// Edit vote permission constants.
+const (
+	EditVotePermsAdmin      = "admin"
+	EditVotePermsAdminVoter = "admin_voter"
+	EditVotePermsVoter      = "voter"
+	EditVotePermsNobody     = "nobody"
+)
+
 // Poll represents a StrawPoll poll.
 type Poll struct {
 	ID          string        `json:"id"`
@@ -147,3 +155,8 @@ type PollListResponse struct {
 	Data       []Poll     `json:"data"`
 	Pagination Pagination `json:"pagination"`
 }
+
+// Items returns the polls on this page.
+func (r *PollListResponse) Items() any {
+	return r.Data
+}
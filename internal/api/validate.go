@@ -0,0 +1,230 @@
+package api
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+)
+
+// Option count limits enforced by StrawPoll.
+const (
+	MinPollOptions = 2
+	MaxPollOptions = 30
+)
+
+// Allowed enum values for poll configuration fields.
+var (
+	validPollTypes         = []string{PollTypeMultipleChoice, PollTypeMeeting, PollTypeRanking}
+	validDupchecks         = []string{DupcheckIP, DupcheckSession, DupcheckNone}
+	validVoteTypes         = []string{VoteTypeDefault, VoteTypeParticipantGrid}
+	validEditVotePerms     = []string{EditVotePermsAdmin, EditVotePermsAdminVoter, EditVotePermsVoter, EditVotePermsNobody}
+	validResultsVisibility = []string{
+		ResultsVisibilityAlways, ResultsVisibilityAfterDeadline,
+		ResultsVisibilityAfterVote, ResultsVisibilityHidden,
+	}
+)
+
+// ValidationErrors collects every field violation found in a request.
+type ValidationErrors []*ValidationError
+
+func (e ValidationErrors) Error() string {
+	if len(e) == 1 {
+		return e[0].Error()
+	}
+
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "validation: %d problems", len(e))
+
+	for _, v := range e {
+		fmt.Fprintf(&b, "\n  %s %s", v.Field, v.Message)
+	}
+
+	return b.String()
+}
+
+// validator accumulates field errors.
+type validator struct {
+	errs ValidationErrors
+}
+
+func (v *validator) add(field, format string, args ...any) {
+	v.errs = append(v.errs, &ValidationError{Field: field, Message: fmt.Sprintf(format, args...)})
+}
+
+func (v *validator) enum(field, value string, allowed []string) {
+	if value != "" && !slices.Contains(allowed, value) {
+		v.add(field, "must be one of %s, got %q", strings.Join(allowed, ", "), value)
+	}
+}
+
+func (v *validator) err() error {
+	if len(v.errs) == 0 {
+		return nil
+	}
+
+	return v.errs
+}
+
+// ValidateCreatePollRequest checks req against StrawPoll's rules and returns
+// all violations at once as ValidationErrors, or nil if the request is valid.
+// An empty Type is treated as multiple_choice, matching CreatePoll.
+func ValidateCreatePollRequest(req *CreatePollRequest, now time.Time) error {
+	v := &validator{}
+
+	if strings.TrimSpace(req.Title) == "" {
+		v.add("title", "is required")
+	}
+
+	pollType := req.Type
+	if pollType == "" {
+		pollType = PollTypeMultipleChoice
+	}
+
+	v.enum("type", pollType, validPollTypes)
+	v.options(pollType, req.PollOptions)
+	v.config(req.PollConfig, len(req.PollOptions), now)
+
+	return v.err()
+}
+
+// ValidateUpdatePollRequest checks the fields present in req.
+// Options are only validated when the request replaces them.
+func ValidateUpdatePollRequest(req *UpdatePollRequest, now time.Time) error {
+	v := &validator{}
+
+	if req.PollOptions != nil {
+		v.options("", req.PollOptions)
+	}
+
+	v.config(req.PollConfig, len(req.PollOptions), now)
+
+	return v.err()
+}
+
+// ValidatePollOptions checks option count, uniqueness and per-type rules.
+// pollType may be empty when the poll type is unknown (e.g. on update).
+func ValidatePollOptions(pollType string, opts []*PollOption) error {
+	v := &validator{}
+	v.options(pollType, opts)
+
+	return v.err()
+}
+
+func (v *validator) options(pollType string, opts []*PollOption) {
+	if n := len(opts); n < MinPollOptions || n > MaxPollOptions {
+		v.add("poll_options", "requires %d-%d options, got %d", MinPollOptions, MaxPollOptions, n)
+	}
+
+	seen := make(map[string]int, len(opts))
+
+	for i, opt := range opts {
+		field := fmt.Sprintf("poll_options[%d]", i)
+
+		if opt == nil {
+			v.add(field, "is empty")
+
+			continue
+		}
+
+		if pollType == PollTypeMeeting && opt.Type != OptionTypeDate && opt.Type != OptionTypeTimeRange {
+			v.add(field+".type", "must be %s or %s for meeting polls, got %q", OptionTypeDate, OptionTypeTimeRange, opt.Type)
+		}
+
+		switch opt.Type {
+		case OptionTypeDate:
+			if _, err := time.Parse("2006-01-02", opt.Date); err != nil {
+				v.add(field+".date", "must be YYYY-MM-DD, got %q", opt.Date)
+			}
+		case OptionTypeTimeRange:
+			if opt.StartTime == nil {
+				v.add(field+".start_time", "is required for time ranges")
+			} else if opt.EndTime != nil && *opt.EndTime <= *opt.StartTime {
+				v.add(field+".end_time", "must be after start time")
+			}
+		case "", OptionTypeText:
+			if strings.TrimSpace(opt.Value) == "" {
+				v.add(field+".value", "is required")
+
+				continue
+			}
+		default:
+			v.add(field+".type", "must be one of %s, %s, %s, got %q", OptionTypeText, OptionTypeDate, OptionTypeTimeRange, opt.Type)
+		}
+
+		key, name, shown := optionKey(opt)
+		if j, dup := seen[key]; dup && key != "" {
+			v.add(field+"."+name, "duplicates poll_options[%d] (%s)", j, shown)
+		} else {
+			seen[key] = i
+		}
+	}
+}
+
+// optionKey identifies an option for the duplicate check: dates by day,
+// time ranges by start and end, text by value regardless of case. It also
+// returns the field to report and the option as shown in the message.
+func optionKey(opt *PollOption) (key, field, shown string) {
+	switch opt.Type {
+	case OptionTypeDate:
+		if opt.Date == "" {
+			return "", "date", ""
+		}
+
+		return "date:" + opt.Date, "date", opt.Date
+	case OptionTypeTimeRange:
+		if opt.StartTime == nil {
+			return "", "start_time", ""
+		}
+
+		start := time.Unix(*opt.StartTime, 0).UTC()
+		key, shown = fmt.Sprintf("range:%d-", *opt.StartTime), start.Format(time.RFC3339)
+
+		if opt.EndTime != nil {
+			key += fmt.Sprint(*opt.EndTime)
+			shown += "/" + time.Unix(*opt.EndTime, 0).UTC().Format(time.RFC3339)
+		}
+
+		return key, "start_time", shown
+	default:
+		return "text:" + strings.ToLower(strings.TrimSpace(opt.Value)), "value", fmt.Sprintf("%q", opt.Value)
+	}
+}
+
+func (v *validator) config(cfg *PollConfig, optionCount int, now time.Time) {
+	if cfg == nil {
+		return
+	}
+
+	v.enum("poll_config.duplication_checking", cfg.DuplicationChecking, validDupchecks)
+	v.enum("poll_config.results_visibility", cfg.ResultsVisibility, validResultsVisibility)
+	v.enum("poll_config.vote_type", cfg.VoteType, validVoteTypes)
+	v.enum("poll_config.edit_vote_permissions", cfg.EditVotePermissions, validEditVotePerms)
+
+	if cfg.DeadlineAt != nil && *cfg.DeadlineAt <= now.Unix() {
+		v.add("poll_config.deadline_at", "must be in the future, got %s", time.Unix(*cfg.DeadlineAt, 0).Format(time.RFC3339))
+	}
+
+	if cfg.IsMultipleChoice == nil || !*cfg.IsMultipleChoice {
+		return
+	}
+
+	lo, hi := cfg.MultipleChoiceMin, cfg.MultipleChoiceMax
+
+	if lo != nil && *lo < 1 {
+		v.add("poll_config.multiple_choice_min", "must be at least 1, got %d", *lo)
+	}
+
+	if lo != nil && hi != nil && *lo > *hi {
+		v.add("poll_config.multiple_choice_min", "must not exceed multiple_choice_max (%d > %d)", *lo, *hi)
+	}
+
+	if optionCount > 0 {
+		if hi != nil && *hi > optionCount {
+			v.add("poll_config.multiple_choice_max", "must not exceed the number of options (%d > %d)", *hi, optionCount)
+		} else if lo != nil && hi == nil && *lo > optionCount {
+			v.add("poll_config.multiple_choice_min", "must not exceed the number of options (%d > %d)", *lo, optionCount)
+		}
+	}
+}
@@ -0,0 +1,210 @@
+package api
+
+import (
+	"errors"
+	"slices"
+	"testing"
+	"time"
+)
+
+func textOptions(values ...string) []*PollOption {
+	opts := make([]*PollOption, len(values))
+	for i, v := range values {
+		opts[i] = &PollOption{Type: OptionTypeText, Value: v, Position: i}
+	}
+
+	return opts
+}
+
+func fieldsOf(t *testing.T, err error) []string {
+	t.Helper()
+
+	if err == nil {
+		return nil
+	}
+
+	var verrs ValidationErrors
+	if !errors.As(err, &verrs) {
+		t.Fatalf("error %v is not ValidationErrors", err)
+	}
+
+	fields := make([]string, len(verrs))
+	for i, v := range verrs {
+		fields[i] = v.Field
+	}
+
+	return fields
+}
+
+func TestValidateCreatePollRequest(t *testing.T) {
+	now := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	past := now.Add(-time.Hour).Unix()
+	future := now.Add(time.Hour).Unix()
+	start := now.Unix()
+	before := start - 60
+
+	tests := []struct {
+		name string
+		req  *CreatePollRequest
+		want []string
+	}{
+		{
+			name: "valid",
+			req:  &CreatePollRequest{Title: "Lunch", PollOptions: textOptions("Pizza", "Sushi")},
+		},
+		{
+			name: "missing title and too few options",
+			req:  &CreatePollRequest{PollOptions: textOptions("Pizza")},
+			want: []string{"title", "poll_options"},
+		},
+		{
+			name: "too many options",
+			req:  &CreatePollRequest{Title: "x", PollOptions: make31()},
+			want: []string{"poll_options"},
+		},
+		{
+			name: "duplicate option values",
+			req:  &CreatePollRequest{Title: "x", PollOptions: textOptions("Pizza", "pizza ")},
+			want: []string{"poll_options[1].value"},
+		},
+		{
+			name: "bad enums",
+			req: &CreatePollRequest{Title: "x", PollOptions: textOptions("a", "b"), PollConfig: &PollConfig{
+				DuplicationChecking: "cookie",
+				ResultsVisibility:   "never",
+				VoteType:            "grid",
+				EditVotePermissions: "everyone",
+			}},
+			want: []string{
+				"poll_config.duplication_checking", "poll_config.results_visibility",
+				"poll_config.vote_type", "poll_config.edit_vote_permissions",
+			},
+		},
+		{
+			name: "deadline in the past",
+			req: &CreatePollRequest{Title: "x", PollOptions: textOptions("a", "b"), PollConfig: &PollConfig{
+				DeadlineAt: &past,
+			}},
+			want: []string{"poll_config.deadline_at"},
+		},
+		{
+			name: "deadline in the future",
+			req: &CreatePollRequest{Title: "x", PollOptions: textOptions("a", "b"), PollConfig: &PollConfig{
+				DeadlineAt: &future,
+			}},
+		},
+		{
+			name: "multiple choice min above max",
+			req: &CreatePollRequest{Title: "x", PollOptions: textOptions("a", "b", "c"), PollConfig: &PollConfig{
+				IsMultipleChoice:  ptr(true),
+				MultipleChoiceMin: ptr(3),
+				MultipleChoiceMax: ptr(2),
+			}},
+			want: []string{"poll_config.multiple_choice_min"},
+		},
+		{
+			name: "multiple choice max above option count",
+			req: &CreatePollRequest{Title: "x", PollOptions: textOptions("a", "b"), PollConfig: &PollConfig{
+				IsMultipleChoice:  ptr(true),
+				MultipleChoiceMax: ptr(5),
+			}},
+			want: []string{"poll_config.multiple_choice_max"},
+		},
+		{
+			name: "meeting options must be dates or ranges",
+			req: &CreatePollRequest{
+				Title: "x",
+				Type:  PollTypeMeeting,
+				PollOptions: []*PollOption{
+					{Type: OptionTypeDate, Value: "2025-06-02", Date: "2025-06-02"},
+					{Type: OptionTypeText, Value: "Tuesday"},
+					{Type: OptionTypeTimeRange, Value: "r", StartTime: &start, EndTime: &before},
+					{Type: OptionTypeDate, Value: "bad", Date: "June 4"},
+				},
+			},
+			want: []string{"poll_options[1].type", "poll_options[2].end_time", "poll_options[3].date"},
+		},
+		{
+			name: "open-ended time range is valid",
+			req: &CreatePollRequest{
+				Title: "x",
+				Type:  PollTypeMeeting,
+				PollOptions: []*PollOption{
+					{Type: OptionTypeTimeRange, Value: "r1", StartTime: &start},
+					{Type: OptionTypeDate, Value: "2025-06-02", Date: "2025-06-02"},
+				},
+			},
+		},
+		{
+			name: "duplicate meeting dates and slots",
+			req: &CreatePollRequest{
+				Title: "x",
+				Type:  PollTypeMeeting,
+				PollOptions: []*PollOption{
+					{Type: OptionTypeDate, Date: "2025-06-02"},
+					{Type: OptionTypeTimeRange, StartTime: &start, EndTime: &future},
+					{Type: OptionTypeDate, Date: "2025-06-02"},
+					{Type: OptionTypeTimeRange, StartTime: &start, EndTime: &future},
+					{Type: OptionTypeDate, Date: "2025-06-03"},
+				},
+			},
+			want: []string{"poll_options[2].date", "poll_options[3].start_time"},
+		},
+		{
+			name: "slots sharing a start are distinct",
+			req: &CreatePollRequest{
+				Title: "x",
+				Type:  PollTypeMeeting,
+				PollOptions: []*PollOption{
+					{Type: OptionTypeTimeRange, StartTime: &start, EndTime: &future},
+					{Type: OptionTypeTimeRange, StartTime: &start},
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := fieldsOf(t, ValidateCreatePollRequest(tt.req, now))
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("fields = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestValidateUpdatePollRequest(t *testing.T) {
+	now := time.Now()
+
+	if err := ValidateUpdatePollRequest(&UpdatePollRequest{Title: "New"}, now); err != nil {
+		t.Errorf("title-only update: %v", err)
+	}
+
+	err := ValidateUpdatePollRequest(&UpdatePollRequest{PollOptions: textOptions("only")}, now)
+	if got := fieldsOf(t, err); !slices.Equal(got, []string{"poll_options"}) {
+		t.Errorf("fields = %v, want [poll_options]", got)
+	}
+}
+
+func TestValidationErrorsMessage(t *testing.T) {
+	err := ValidateCreatePollRequest(&CreatePollRequest{}, time.Now())
+	if err == nil {
+		t.Fatal("expected error")
+	}
+
+	want := "validation: 2 problems\n  title is required\n  poll_options requires 2-30 options, got 0"
+	if err.Error() != want {
+		t.Errorf("Error() = %q, want %q", err.Error(), want)
+	}
+}
+
+func make31() []*PollOption {
+	values := make([]string, 31)
+	for i := range values {
+		values[i] = string(rune('A'+i%26)) + string(rune('a'+i/26))
+	}
+
+	return textOptions(values...)
+}
+
+func ptr[T any](v T) *T { return &v }
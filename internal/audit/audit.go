@@ -0,0 +1,469 @@
+// Package audit flags suspicious voting patterns in a poll's participants.
+package audit
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/dedene/strawpoll-cli/internal/output"
+)
+
+// Severity ranks how strongly a finding suggests manipulation.
+type Severity string
+
+const (
+	Low    Severity = "low"
+	Medium Severity = "medium"
+	High   Severity = "high"
+)
+
+// Severities lists the severities from least to most severe.
+var Severities = []Severity{Low, Medium, High}
+
+func (s Severity) rank() int {
+	return slices.Index(Severities, s)
+}
+
+// AtLeast reports whether s is at least as severe as min.
+func (s Severity) AtLeast(min Severity) bool {
+	return s.rank() >= min.rank()
+}
+
+// raise returns the next severity up, capped at High.
+func (s Severity) raise() Severity {
+	return Severities[min(s.rank()+1, len(Severities)-1)]
+}
+
+// Kind names a detector.
+type Kind string
+
+const (
+	DuplicateName Kind = "duplicate-name"
+	SimilarName   Kind = "similar-name"
+	Burst         Kind = "burst"
+	BallotCluster Kind = "ballot-cluster"
+	CountrySpike  Kind = "country-spike"
+)
+
+// Vote is one participant's vote as the detectors see it.
+type Vote struct {
+	ID      string
+	Name    string
+	Country string
+	At      time.Time
+	// Ballot is a canonical rendering of the participant's choices, equal
+	// for identical ballots.
+	Ballot string
+}
+
+// Finding is one suspicious pattern with the evidence behind it.
+type Finding struct {
+	Kind     Kind     `json:"kind"`
+	Severity Severity `json:"severity"`
+	Summary  string   `json:"summary"`
+	Evidence []string `json:"evidence"`
+	// Participants are the IDs of the votes involved.
+	Participants []string  `json:"participants"`
+	At           time.Time `json:"at"`
+}
+
+// Options tunes the detectors. Zero values take the defaults.
+type Options struct {
+	// BurstWindow and BurstSize flag BurstSize or more votes within
+	// BurstWindow (default 3 within 10s).
+	BurstWindow time.Duration
+	BurstSize   int
+	// ClusterWindow and ClusterSize flag ClusterSize or more identical
+	// ballots within ClusterWindow (default 3 within 5m).
+	ClusterWindow time.Duration
+	ClusterSize   int
+	// SpikeWindow and SpikeSize flag SpikeSize or more votes from one
+	// country within SpikeWindow when that country is otherwise rare
+	// (default 5 within 15m).
+	SpikeWindow time.Duration
+	SpikeSize   int
+	// NameDistance is the largest edit distance between normalized names
+	// reported as similar (default 1, or 2 for names of 8+ characters).
+	NameDistance int
+	// Unprotected raises duplicate-name and ballot-cluster findings one
+	// level, for polls without duplicate vote checking.
+	Unprotected bool
+}
+
+func (o Options) withDefaults() Options {
+	if o.BurstWindow <= 0 {
+		o.BurstWindow = 10 * time.Second
+	}
+
+	if o.BurstSize <= 0 {
+		o.BurstSize = 3
+	}
+
+	if o.ClusterWindow <= 0 {
+		o.ClusterWindow = 5 * time.Minute
+	}
+
+	if o.ClusterSize <= 0 {
+		o.ClusterSize = 3
+	}
+
+	if o.SpikeWindow <= 0 {
+		o.SpikeWindow = 15 * time.Minute
+	}
+
+	if o.SpikeSize <= 0 {
+		o.SpikeSize = 5
+	}
+
+	return o
+}
+
+// Run applies every detector to votes and returns the findings, most
+// severe first and then by time.
+func Run(votes []Vote, opts Options) []Finding {
+	opts = opts.withDefaults()
+
+	sorted := slices.Clone(votes)
+	sort.SliceStable(sorted, func(i, j int) bool { return sorted[i].At.Before(sorted[j].At) })
+
+	var findings []Finding
+
+	findings = append(findings, names(sorted, opts)...)
+	findings = append(findings, bursts(sorted, opts)...)
+	findings = append(findings, clusters(sorted, opts)...)
+	findings = append(findings, spikes(sorted, opts)...)
+
+	sort.SliceStable(findings, func(i, j int) bool {
+		if findings[i].Severity != findings[j].Severity {
+			return findings[i].Severity.rank() > findings[j].Severity.rank()
+		}
+
+		return findings[i].At.Before(findings[j].At)
+	})
+
+	return findings
+}
+
+// Normalize folds a name for comparison: lowercase letters and digits only.
+func Normalize(name string) string {
+	return strings.Map(func(r rune) rune {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			return unicode.ToLower(r)
+		}
+
+		return -1
+	}, name)
+}
+
+// Distance is the Levenshtein edit distance between a and b, in runes.
+func Distance(a, b string) int {
+	ra, rb := []rune(a), []rune(b)
+	prev := make([]int, len(rb)+1)
+	cur := make([]int, len(rb)+1)
+
+	for j := range prev {
+		prev[j] = j
+	}
+
+	for i := 1; i <= len(ra); i++ {
+		cur[0] = i
+
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+
+			cur[j] = min(prev[j]+1, cur[j-1]+1, prev[j-1]+cost)
+		}
+
+		prev, cur = cur, prev
+	}
+
+	return prev[len(rb)]
+}
+
+func describe(v Vote) string {
+	name := v.Name
+	if name == "" {
+		name = "Anonymous"
+	}
+
+	s := fmt.Sprintf("%s at %s", name, output.Times().DateTime(v.At))
+	if v.Country != "" {
+		s += " from " + strings.ToUpper(v.Country)
+	}
+
+	return s
+}
+
+func ids(votes []Vote) []string {
+	out := make([]string, len(votes))
+	for i, v := range votes {
+		out[i] = v.ID
+	}
+
+	return out
+}
+
+func evidence(votes []Vote) []string {
+	out := make([]string, len(votes))
+	for i, v := range votes {
+		out[i] = describe(v)
+	}
+
+	return out
+}
+
+// names flags participants whose normalized names are equal, or within
+// the edit distance of each other.
+func names(votes []Vote, opts Options) []Finding {
+	groups := make(map[string][]Vote)
+
+	var keys []string
+
+	for _, v := range votes {
+		key := Normalize(v.Name)
+		if key == "" || key == "anonymous" {
+			continue
+		}
+
+		if _, ok := groups[key]; !ok {
+			keys = append(keys, key)
+		}
+
+		groups[key] = append(groups[key], v)
+	}
+
+	var findings []Finding
+
+	for _, key := range keys {
+		g := groups[key]
+		if len(g) < 2 {
+			continue
+		}
+
+		sev := Medium
+		if len(g) > 2 {
+			sev = High
+		}
+
+		if opts.Unprotected {
+			sev = sev.raise()
+		}
+
+		findings = append(findings, Finding{
+			Kind:         DuplicateName,
+			Severity:     sev,
+			Summary:      fmt.Sprintf("%d participants named %q", len(g), g[0].Name),
+			Evidence:     evidence(g),
+			Participants: ids(g),
+			At:           g[0].At,
+		})
+	}
+
+	for i, a := range keys {
+		for _, b := range keys[i+1:] {
+			limit := opts.NameDistance
+			if limit <= 0 {
+				limit = 1
+				if min(len([]rune(a)), len([]rune(b))) >= 8 {
+					limit = 2
+				}
+			}
+
+			// Short names collide by chance too often to mean anything.
+			if min(len([]rune(a)), len([]rune(b))) < 4 || Distance(a, b) > limit {
+				continue
+			}
+
+			g := append(slices.Clone(groups[a]), groups[b]...)
+			findings = append(findings, Finding{
+				Kind:         SimilarName,
+				Severity:     Low,
+				Summary:      fmt.Sprintf("names %q and %q are nearly identical", groups[a][0].Name, groups[b][0].Name),
+				Evidence:     evidence(g),
+				Participants: ids(g),
+				At:           g[0].At,
+			})
+		}
+	}
+
+	return findings
+}
+
+// windows returns the maximal runs of votes (sorted by time) where at least
+// size votes fall within window of each other. Overlapping runs merge.
+func windows(votes []Vote, window time.Duration, size int) [][]Vote {
+	var (
+		runs     [][]Vote
+		runStart = -1
+		runEnd   = -1
+	)
+
+	for start, end := 0, 0; end < len(votes); end++ {
+		for votes[end].At.Sub(votes[start].At) > window {
+			start++
+		}
+
+		if end-start+1 < size {
+			continue
+		}
+
+		if runStart >= 0 && start <= runEnd {
+			runEnd = end
+
+			continue
+		}
+
+		if runStart >= 0 {
+			runs = append(runs, votes[runStart:runEnd+1])
+		}
+
+		runStart, runEnd = start, end
+	}
+
+	if runStart >= 0 {
+		runs = append(runs, votes[runStart:runEnd+1])
+	}
+
+	return runs
+}
+
+func span(run []Vote) time.Duration {
+	return run[len(run)-1].At.Sub(run[0].At)
+}
+
+// bursts flags many votes arriving within seconds of each other.
+func bursts(votes []Vote, opts Options) []Finding {
+	var findings []Finding
+
+	for _, run := range windows(votes, opts.BurstWindow, opts.BurstSize) {
+		sev := Low
+		if len(run) >= 2*opts.BurstSize {
+			sev = Medium
+		}
+
+		findings = append(findings, Finding{
+			Kind:         Burst,
+			Severity:     sev,
+			Summary:      fmt.Sprintf("%d votes within %s", len(run), span(run)),
+			Evidence:     evidence(run),
+			Participants: ids(run),
+			At:           run[0].At,
+		})
+	}
+
+	return findings
+}
+
+// clusters flags identical ballots arriving close together.
+func clusters(votes []Vote, opts Options) []Finding {
+	byBallot := make(map[string][]Vote)
+
+	var ballots []string
+
+	for _, v := range votes {
+		if v.Ballot == "" {
+			continue
+		}
+
+		if _, ok := byBallot[v.Ballot]; !ok {
+			ballots = append(ballots, v.Ballot)
+		}
+
+		byBallot[v.Ballot] = append(byBallot[v.Ballot], v)
+	}
+
+	var findings []Finding
+
+	for _, b := range ballots {
+		for _, run := range windows(byBallot[b], opts.ClusterWindow, opts.ClusterSize) {
+			sev := Medium
+			if len(run) >= 2*opts.ClusterSize {
+				sev = High
+			}
+
+			if opts.Unprotected {
+				sev = sev.raise()
+			}
+
+			findings = append(findings, Finding{
+				Kind:         BallotCluster,
+				Severity:     sev,
+				Summary:      fmt.Sprintf("%d identical ballots within %s", len(run), span(run)),
+				Evidence:     evidence(run),
+				Participants: ids(run),
+				At:           run[0].At,
+			})
+		}
+	}
+
+	return findings
+}
+
+// spikes flags a burst of votes from one country that is rare among the
+// rest of the votes.
+func spikes(votes []Vote, opts Options) []Finding {
+	byCountry := make(map[string][]Vote)
+
+	var countries []string
+
+	for _, v := range votes {
+		c := strings.ToUpper(v.Country)
+		if c == "" {
+			continue
+		}
+
+		if _, ok := byCountry[c]; !ok {
+			countries = append(countries, c)
+		}
+
+		byCountry[c] = append(byCountry[c], v)
+	}
+
+	var findings []Finding
+
+	for _, c := range countries {
+		for _, run := range windows(byCountry[c], opts.SpikeWindow, opts.SpikeSize) {
+			others := len(votes) - len(run)
+			fromCountry := len(byCountry[c]) - len(run)
+
+			// A country that votes heavily throughout is the poll's
+			// audience, not a spike. Without other votes there is no
+			// audience to compare with.
+			if others == 0 || float64(fromCountry)/float64(others) >= 0.2 {
+				continue
+			}
+
+			sev := Medium
+			if len(run) >= 2*opts.SpikeSize {
+				sev = High
+			}
+
+			findings = append(findings, Finding{
+				Kind:     CountrySpike,
+				Severity: sev,
+				Summary: fmt.Sprintf("%d votes from %s within %s; %s is %.0f%% of the other votes",
+					len(run), c, span(run), c, share(fromCountry, others)),
+				Evidence:     evidence(run),
+				Participants: ids(run),
+				At:           run[0].At,
+			})
+		}
+	}
+
+	return findings
+}
+
+func share(part, total int) float64 {
+	if total == 0 {
+		return 0
+	}
+
+	return float64(part) / float64(total) * 100
+}
@@ -0,0 +1,147 @@
+package audit
+
+import (
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/dedene/strawpoll-cli/internal/output"
+)
+
+var t0 = time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC)
+
+func vote(id, name, country string, offset time.Duration, ballot string) Vote {
+	return Vote{ID: id, Name: name, Country: country, At: t0.Add(offset), Ballot: ballot}
+}
+
+func kinds(findings []Finding) []Kind {
+	out := make([]Kind, len(findings))
+	for i, f := range findings {
+		out[i] = f.Kind
+	}
+
+	return out
+}
+
+func TestDistance(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want int
+	}{
+		{"kitten", "sitting", 3},
+		{"anna", "anna", 0},
+		{"", "abc", 3},
+		{"jérôme", "jerome", 2},
+	}
+
+	for _, tt := range tests {
+		if got := Distance(tt.a, tt.b); got != tt.want {
+			t.Errorf("Distance(%q, %q) = %d, want %d", tt.a, tt.b, got, tt.want)
+		}
+	}
+}
+
+func TestNormalize(t *testing.T) {
+	if got := Normalize(" Jean-Luc  O'Neil "); got != "jeanluconeil" {
+		t.Errorf("Normalize = %q", got)
+	}
+}
+
+func TestRun_Names(t *testing.T) {
+	votes := []Vote{
+		vote("1", "Anna Smith", "", 0, "a"),
+		vote("2", "anna.smith", "", time.Hour, "b"),
+		vote("3", "Annna Smith", "", 2*time.Hour, "c"),
+		vote("4", "Bob", "", 3*time.Hour, "d"),
+		vote("5", "Rob", "", 4*time.Hour, "e"),
+	}
+
+	got := Run(votes, Options{})
+	if want := []Kind{DuplicateName, SimilarName}; !slices.Equal(kinds(got), want) {
+		t.Fatalf("kinds = %v, want %v", kinds(got), want)
+	}
+
+	if got[0].Severity != Medium || !slices.Equal(got[0].Participants, []string{"1", "2"}) {
+		t.Errorf("duplicate = %+v", got[0])
+	}
+
+	if got := Run(votes, Options{Unprotected: true}); got[0].Severity != High {
+		t.Errorf("unprotected duplicate severity = %s, want high", got[0].Severity)
+	}
+}
+
+func TestRun_BurstAndCluster(t *testing.T) {
+	var votes []Vote
+	for i, off := range []time.Duration{0, 2 * time.Second, 4 * time.Second, 9 * time.Second, time.Hour} {
+		votes = append(votes, vote(string(rune('a'+i)), "", "", off, "1,2"))
+	}
+
+	got := Run(votes, Options{})
+	if want := []Kind{BallotCluster, Burst}; !slices.Equal(kinds(got), want) {
+		t.Fatalf("kinds = %v, want %v", kinds(got), want)
+	}
+
+	if len(got[1].Participants) != 4 {
+		t.Errorf("burst participants = %v, want 4", got[1].Participants)
+	}
+}
+
+func TestRun_CountrySpike(t *testing.T) {
+	var votes []Vote
+	for i := range 20 {
+		votes = append(votes, vote("be"+string(rune('a'+i)), "", "be", time.Duration(i)*time.Hour, ""))
+	}
+
+	for i := range 6 {
+		votes = append(votes, vote("ru"+string(rune('a'+i)), "", "ru", 5*time.Hour+time.Duration(i)*time.Minute, ""))
+	}
+
+	got := Run(votes, Options{})
+	if want := []Kind{CountrySpike}; !slices.Equal(kinds(got), want) {
+		t.Fatalf("kinds = %v, want %v", kinds(got), want)
+	}
+
+	if len(got[0].Participants) != 6 {
+		t.Errorf("spike = %+v", got[0])
+	}
+}
+
+func TestRun_SingleCountry(t *testing.T) {
+	var votes []Vote
+	for i := range 6 {
+		votes = append(votes, vote("be"+string(rune('a'+i)), "", "be", time.Duration(i)*time.Minute, ""))
+	}
+
+	// Every vote coming from one country is the poll's audience.
+	if got := Run(votes, Options{}); slices.Contains(kinds(got), CountrySpike) {
+		t.Errorf("single-country poll flagged a spike: %+v", got)
+	}
+}
+
+func TestRun_EvidenceTimeFormat(t *testing.T) {
+	prev := output.Times()
+	t.Cleanup(func() { output.SetTimeFormat(prev) })
+
+	output.SetTimeFormat(output.TimeFormat{Style: output.TimeISO})
+
+	votes := []Vote{
+		vote("a", "Alice", "be", 0, ""),
+		vote("b", "", "", time.Second, ""),
+		vote("c", "", "", 2*time.Second, ""),
+	}
+
+	got := Run(votes, Options{})
+	if len(got) == 0 {
+		t.Fatal("no findings")
+	}
+
+	if want := "Alice at 2026-10-01T12:00:00Z from BE"; !slices.Contains(got[0].Evidence, want) {
+		t.Errorf("evidence = %q, want %q", got[0].Evidence, want)
+	}
+}
+
+func TestSeverity_AtLeast(t *testing.T) {
+	if !High.AtLeast(Medium) || Low.AtLeast(Medium) || !Medium.AtLeast(Medium) {
+		t.Error("AtLeast ordering is wrong")
+	}
+}
@@ -0,0 +1,305 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/alecthomas/kong"
+
+	"github.com/dedene/strawpoll-cli/internal/config"
+)
+
+// AliasCmd manages user-defined command aliases.
+type AliasCmd struct {
+	Set    AliasSetCmd    `cmd:"" help:"Create or replace an alias"`
+	List   AliasListCmd   `cmd:"" help:"List aliases"`
+	Delete AliasDeleteCmd `cmd:"" help:"Delete an alias"`
+}
+
+// AliasSetCmd creates or replaces an alias.
+type AliasSetCmd struct {
+	Name      string   `arg:"" required:"" help:"Alias name"`
+	Expansion []string `arg:"" required:"" passthrough:"" help:"Command the alias expands to; use $1..$9 or $@ for arguments"`
+}
+
+// Run validates and stores an alias in config.yaml.
+func (c *AliasSetCmd) Run() error {
+	if err := validateAliasName(c.Name); err != nil {
+		return &ExitError{Code: CodeUsage, Err: err}
+	}
+
+	expansion := joinArgs(c.Expansion)
+	if len(c.Expansion) == 1 {
+		expansion = c.Expansion[0]
+	}
+
+	words, err := splitArgs(expansion)
+	if err != nil {
+		return &ExitError{Code: CodeUsage, Err: fmt.Errorf("invalid alias expansion: %w", err)}
+	}
+
+	if len(words) == 0 || !slices.Contains(builtinCommands(), words[0]) {
+		return &ExitError{Code: CodeUsage, Err: fmt.Errorf("alias expansion must start with a built-in command")}
+	}
+
+	cfg, err := config.ReadConfig()
+	if err != nil {
+		return fmt.Errorf("read config: %w", err)
+	}
+
+	if cfg.Aliases == nil {
+		cfg.Aliases = make(map[string]string)
+	}
+
+	cfg.Aliases[c.Name] = expansion
+
+	if err := config.WriteConfig(cfg); err != nil {
+		return fmt.Errorf("write config: %w", err)
+	}
+
+	fmt.Fprintf(os.Stdout, "%s = %s\n", c.Name, expansion)
+
+	return nil
+}
+
+// AliasListCmd lists configured aliases.
+type AliasListCmd struct{}
+
+// Run prints all aliases sorted by name.
+func (c *AliasListCmd) Run(flags *RootFlags) error {
+	cfg, err := config.ReadConfig()
+	if err != nil {
+		return fmt.Errorf("read config: %w", err)
+	}
+
+	names := make([]string, 0, len(cfg.Aliases))
+	for name := range cfg.Aliases {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	rows := make([][]string, 0, len(names))
+	for _, name := range names {
+		rows = append(rows, []string{name, cfg.Aliases[name]})
+	}
+
+	aliases := cfg.Aliases
+	if aliases == nil {
+		aliases = map[string]string{}
+	}
+
+	f := newFormatter(flags)
+
+	return f.Output(aliases, []string{"Alias", "Expansion"}, rows)
+}
+
+// AliasDeleteCmd removes an alias.
+type AliasDeleteCmd struct {
+	Name string `arg:"" required:"" help:"Alias name"`
+}
+
+// Run removes an alias from config.yaml.
+func (c *AliasDeleteCmd) Run() error {
+	cfg, err := config.ReadConfig()
+	if err != nil {
+		return fmt.Errorf("read config: %w", err)
+	}
+
+	if _, ok := cfg.Aliases[c.Name]; !ok {
+		return fmt.Errorf("no alias named %q", c.Name)
+	}
+
+	delete(cfg.Aliases, c.Name)
+
+	if err := config.WriteConfig(cfg); err != nil {
+		return fmt.Errorf("write config: %w", err)
+	}
+
+	fmt.Fprintf(os.Stdout, "Alias %s deleted.\n", c.Name)
+
+	return nil
+}
+
+func validateAliasName(name string) error {
+	if name == "" || strings.HasPrefix(name, "-") || strings.ContainsAny(name, " \t\n'\"$") {
+		return fmt.Errorf("invalid alias name %q", name)
+	}
+
+	if slices.Contains(builtinCommands(), name) {
+		return fmt.Errorf("alias %q would shadow a built-in command", name)
+	}
+
+	return nil
+}
+
+// builtinCommands returns the names of the top-level commands.
+func builtinCommands() []string {
+	parser, err := newParser()
+	if err != nil {
+		return nil
+	}
+
+	return commandNames(parser)
+}
+
+func commandNames(parser *kong.Kong) []string {
+	names := make([]string, 0, len(parser.Model.Children))
+	for _, node := range parser.Model.Children {
+		names = append(names, node.Name)
+		names = append(names, node.Aliases...)
+	}
+
+	return names
+}
+
+// expandAlias rewrites args when the first argument names an alias.
+// Built-in commands always win over aliases, and expansion is not recursive.
+func expandAlias(args []string, aliases map[string]string, builtins []string) ([]string, error) {
+	if len(args) == 0 || slices.Contains(builtins, args[0]) {
+		return args, nil
+	}
+
+	expansion, ok := aliases[args[0]]
+	if !ok {
+		return args, nil
+	}
+
+	words, err := splitArgs(expansion)
+	if err != nil {
+		return nil, fmt.Errorf("alias %s: %w", args[0], err)
+	}
+
+	expanded, err := substituteArgs(words, args[1:])
+	if err != nil {
+		return nil, fmt.Errorf("alias %s: %w", args[0], err)
+	}
+
+	return expanded, nil
+}
+
+// substituteArgs replaces $1..$9 with positional arguments and a bare $@ word
+// with all of them. Arguments not consumed by a placeholder are appended.
+func substituteArgs(words, params []string) ([]string, error) {
+	out := make([]string, 0, len(words)+len(params))
+	used := 0
+	all := false
+
+	for _, w := range words {
+		if w == "$@" {
+			out = append(out, params...)
+			all = true
+
+			continue
+		}
+
+		var b strings.Builder
+
+		for i := 0; i < len(w); i++ {
+			if w[i] == '$' && i+1 < len(w) && w[i+1] >= '1' && w[i+1] <= '9' {
+				n, _ := strconv.Atoi(w[i+1 : i+2])
+				if n > len(params) {
+					return nil, fmt.Errorf("missing argument $%d", n)
+				}
+
+				b.WriteString(params[n-1])
+				used = max(used, n)
+				i++
+
+				continue
+			}
+
+			b.WriteByte(w[i])
+		}
+
+		out = append(out, b.String())
+	}
+
+	if !all {
+		out = append(out, params[used:]...)
+	}
+
+	return out, nil
+}
+
+// splitArgs splits s into words using shell-like quoting rules:
+// single quotes are literal, double quotes allow backslash escapes.
+func splitArgs(s string) ([]string, error) {
+	var (
+		words   []string
+		cur     strings.Builder
+		inWord  bool
+		quote   rune
+		escaped bool
+	)
+
+	for _, r := range s {
+		switch {
+		case escaped:
+			cur.WriteRune(r)
+			escaped = false
+		case quote == '\'':
+			if r == '\'' {
+				quote = 0
+			} else {
+				cur.WriteRune(r)
+			}
+		case r == '\\' && quote != '\'':
+			escaped = true
+			inWord = true
+		case quote == '"':
+			if r == '"' {
+				quote = 0
+			} else {
+				cur.WriteRune(r)
+			}
+		case r == '\'' || r == '"':
+			quote = r
+			inWord = true
+		case r == ' ' || r == '\t' || r == '\n':
+			if inWord {
+				words = append(words, cur.String())
+				cur.Reset()
+				inWord = false
+			}
+		default:
+			cur.WriteRune(r)
+			inWord = true
+		}
+	}
+
+	if quote != 0 {
+		return nil, fmt.Errorf("unterminated %c quote", quote)
+	}
+
+	if escaped {
+		return nil, fmt.Errorf("trailing backslash")
+	}
+
+	if inWord {
+		words = append(words, cur.String())
+	}
+
+	return words, nil
+}
+
+// joinArgs quotes args where needed so splitArgs yields them back unchanged.
+func joinArgs(args []string) string {
+	quoted := make([]string, len(args))
+
+	for i, a := range args {
+		if a != "" && !strings.ContainsAny(a, " \t\n'\"\\") {
+			quoted[i] = a
+
+			continue
+		}
+
+		quoted[i] = "'" + strings.ReplaceAll(a, "'", `'\''`) + "'"
+	}
+
+	return strings.Join(quoted, " ")
+}
@@ -0,0 +1,102 @@
+package cmd
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestSplitArgs(t *testing.T) {
+	tests := []struct {
+		in   string
+		want []string
+	}{
+		{`poll create "Lunch?" Pizza Sushi`, []string{"poll", "create", "Lunch?", "Pizza", "Sushi"}},
+		{`poll create 'Who'\''s in?' A B`, []string{"poll", "create", "Who's in?", "A", "B"}},
+		{`a  "b c"   d`, []string{"a", "b c", "d"}},
+		{`a "" b`, []string{"a", "", "b"}},
+		{`a\ b`, []string{"a b"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			got, err := splitArgs(tt.in)
+			if err != nil {
+				t.Fatalf("splitArgs error: %v", err)
+			}
+
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("splitArgs(%q) = %q, want %q", tt.in, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSplitArgs_Unterminated(t *testing.T) {
+	if _, err := splitArgs(`poll "oops`); err == nil {
+		t.Error("expected error for unterminated quote")
+	}
+}
+
+func TestJoinArgsRoundTrip(t *testing.T) {
+	args := []string{"poll", "create", "Who's in?", "", `back\slash`, "plain"}
+
+	got, err := splitArgs(joinArgs(args))
+	if err != nil {
+		t.Fatalf("splitArgs error: %v", err)
+	}
+
+	if !reflect.DeepEqual(got, args) {
+		t.Errorf("round trip = %q, want %q", got, args)
+	}
+}
+
+func TestExpandAlias(t *testing.T) {
+	aliases := map[string]string{
+		"lunch":  `poll create "Lunch?" Pizza Sushi Tacos --dupcheck session`,
+		"q":      `poll create "$1" $@`,
+		"res":    `poll results $1 -p`,
+		"poll":   `version`,
+		"broken": `poll "x`,
+	}
+	builtins := []string{"poll", "version"}
+
+	tests := []struct {
+		name    string
+		args    []string
+		want    []string
+		wantErr bool
+	}{
+		{"no alias", []string{"version"}, []string{"version"}, false},
+		{"builtin wins", []string{"poll", "get", "x"}, []string{"poll", "get", "x"}, false},
+		{"extra args appended", []string{"lunch", "--copy"},
+			[]string{"poll", "create", "Lunch?", "Pizza", "Sushi", "Tacos", "--dupcheck", "session", "--copy"}, false},
+		{"positional and all", []string{"q", "Title", "A", "B"},
+			[]string{"poll", "create", "Title", "Title", "A", "B"}, false},
+		{"positional then rest", []string{"res", "abc", "--json"},
+			[]string{"poll", "results", "abc", "-p", "--json"}, false},
+		{"missing positional", []string{"res"}, nil, true},
+		{"bad quoting", []string{"broken"}, nil, true},
+		{"unknown passes through", []string{"nope"}, []string{"nope"}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := expandAlias(tt.args, aliases, builtins)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("expandAlias(%q) = %q, want error", tt.args, got)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("expandAlias error: %v", err)
+			}
+
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("expandAlias(%q) = %q, want %q", tt.args, got, tt.want)
+			}
+		})
+	}
+}
@@ -3,37 +3,52 @@ package cmd
 import (
 	"encoding/json"
 	"fmt"
+	"maps"
 	"os"
+	"slices"
 	"strings"
 
 	"gopkg.in/yaml.v3"
 
 	"github.com/dedene/strawpoll-cli/internal/config"
+	"github.com/dedene/strawpoll-cli/internal/output"
 )
 
 // ConfigCmd manages CLI configuration.
 type ConfigCmd struct {
-	Show ConfigShowCmd `cmd:"" help:"Display current configuration"`
-	Set  ConfigSetCmd  `cmd:"" help:"Set a configuration value"`
-	Path ConfigPathCmd `cmd:"" help:"Show configuration file path"`
+	Show   ConfigShowCmd   `cmd:"" help:"Display current configuration"`
+	Get    ConfigGetCmd    `cmd:"" help:"Print a configuration value"`
+	Set    ConfigSetCmd    `cmd:"" help:"Set a configuration value"`
+	Unset  ConfigUnsetCmd  `cmd:"" help:"Remove a configuration value"`
+	List   ConfigListCmd   `cmd:"" help:"List configuration keys and values"`
+	Schema ConfigSchemaCmd `cmd:"" help:"Print JSON Schema for config.yaml"`
+	Path   ConfigPathCmd   `cmd:"" help:"Show configuration file path"`
 }
 
 // ConfigShowCmd displays the current configuration.
 type ConfigShowCmd struct{}
 
-// Run reads and prints the config file.
+// Run reads and prints the config file: as YAML in table mode, through the
+// formatter in structured modes, and as key/value rows in the other
+// tabular modes.
 func (c *ConfigShowCmd) Run(flags *RootFlags) error {
 	cfg, err := config.ReadConfig()
 	if err != nil {
 		return fmt.Errorf("read config: %w", err)
 	}
 
-	if flags.JSON {
-		enc := json.NewEncoder(os.Stdout)
-		enc.SetIndent("", "  ")
-		enc.SetEscapeHTML(false)
+	f := newFormatter(flags)
+	if f.UsesValue() {
+		return f.Output(cfg, nil, nil)
+	}
+
+	if f.Mode != output.ModeTable {
+		rows, err := configRows(&cfg)
+		if err != nil {
+			return err
+		}
 
-		return enc.Encode(cfg)
+		return f.Output(cfg, []string{"Key", "Value"}, rows)
 	}
 
 	b, err := yaml.Marshal(cfg)
@@ -53,78 +68,197 @@ func (c *ConfigShowCmd) Run(flags *RootFlags) error {
 	return nil
 }
 
+// configRows lists the keys that are set, then aliases and custom themes
+// as "aliases.<name>" and "themes.<name>" rows.
+func configRows(cfg *config.File) ([][]string, error) {
+	var rows [][]string
+
+	for _, k := range config.Keys() {
+		if value, ok := k.Get(cfg); ok {
+			rows = append(rows, []string{k.Name, value})
+		}
+	}
+
+	for _, name := range slices.Sorted(maps.Keys(cfg.Aliases)) {
+		rows = append(rows, []string{"aliases." + name, cfg.Aliases[name]})
+	}
+
+	for _, name := range slices.Sorted(maps.Keys(cfg.Themes)) {
+		b, err := json.Marshal(cfg.Themes[name])
+		if err != nil {
+			return nil, fmt.Errorf("encode theme %s: %w", name, err)
+		}
+
+		rows = append(rows, []string{"themes." + name, string(b)})
+	}
+
+	return rows, nil
+}
+
+// ConfigGetCmd prints a single configuration value.
+type ConfigGetCmd struct {
+	Key string `arg:"" required:"" help:"Configuration key"`
+}
+
+// Run prints the value of a config key, or errors if it is not set.
+func (c *ConfigGetCmd) Run() error {
+	key, err := config.LookupKey(c.Key)
+	if err != nil {
+		return &ExitError{Code: CodeUsage, Err: err}
+	}
+
+	cfg, err := config.ReadConfig()
+	if err != nil {
+		return fmt.Errorf("read config: %w", err)
+	}
+
+	value, ok := key.Get(&cfg)
+	if !ok {
+		return fmt.Errorf("%s is not set", c.Key)
+	}
+
+	fmt.Fprintln(os.Stdout, value)
+
+	return nil
+}
+
 // ConfigSetCmd sets a configuration value.
 type ConfigSetCmd struct {
 	Key   string `arg:"" required:"" help:"Configuration key"`
 	Value string `arg:"" required:"" help:"Configuration value"`
 }
 
-// Run sets a config key to the given value.
+// Run validates and sets a config key to the given value.
 func (c *ConfigSetCmd) Run() error {
+	key, err := config.LookupKey(c.Key)
+	if err != nil {
+		return &ExitError{Code: CodeUsage, Err: err}
+	}
+
 	cfg, err := config.ReadConfig()
 	if err != nil {
 		return fmt.Errorf("read config: %w", err)
 	}
 
-	switch c.Key {
-	case "keyring_backend":
-		cfg.KeyringBackend = c.Value
-	case "dupcheck":
-		cfg.Dupcheck = c.Value
-	case "results_visibility":
-		cfg.ResultsVisibility = c.Value
-	case "is_private":
-		b, err := parseBool(c.Value)
-		if err != nil {
-			return fmt.Errorf("invalid boolean for is_private: %w", err)
-		}
+	if err := key.Set(&cfg, c.Value); err != nil {
+		return &ExitError{Code: CodeUsage, Err: err}
+	}
 
-		cfg.IsPrivate = &b
-	case "allow_comments":
-		b, err := parseBool(c.Value)
-		if err != nil {
-			return fmt.Errorf("invalid boolean for allow_comments: %w", err)
-		}
+	if err := config.WriteConfig(cfg); err != nil {
+		return fmt.Errorf("write config: %w", err)
+	}
 
-		cfg.AllowComments = &b
-	case "allow_vpn_users":
-		b, err := parseBool(c.Value)
-		if err != nil {
-			return fmt.Errorf("invalid boolean for allow_vpn_users: %w", err)
-		}
+	value, _ := key.Get(&cfg)
+	fmt.Fprintf(os.Stdout, "%s = %s\n", c.Key, value)
 
-		cfg.AllowVPN = &b
-	case "hide_participants":
-		b, err := parseBool(c.Value)
-		if err != nil {
-			return fmt.Errorf("invalid boolean for hide_participants: %w", err)
-		}
+	return nil
+}
 
-		cfg.HideParticipants = &b
-	case "edit_vote_permissions":
-		cfg.EditVotePerms = c.Value
-	default:
-		return fmt.Errorf("unknown config key: %s\n\nValid keys: keyring_backend, dupcheck, results_visibility, is_private, allow_comments, allow_vpn_users, hide_participants, edit_vote_permissions", c.Key)
+// ConfigUnsetCmd removes a configuration value.
+type ConfigUnsetCmd struct {
+	Key string `arg:"" required:"" help:"Configuration key"`
+}
+
+// Run clears a config key so the built-in default applies again.
+func (c *ConfigUnsetCmd) Run() error {
+	key, err := config.LookupKey(c.Key)
+	if err != nil {
+		return &ExitError{Code: CodeUsage, Err: err}
+	}
+
+	cfg, err := config.ReadConfig()
+	if err != nil {
+		return fmt.Errorf("read config: %w", err)
 	}
 
+	key.Unset(&cfg)
+
 	if err := config.WriteConfig(cfg); err != nil {
 		return fmt.Errorf("write config: %w", err)
 	}
 
-	fmt.Fprintf(os.Stdout, "%s = %s\n", c.Key, c.Value)
+	fmt.Fprintf(os.Stdout, "%s unset\n", c.Key)
 
 	return nil
 }
 
-func parseBool(s string) (bool, error) {
-	switch strings.ToLower(strings.TrimSpace(s)) {
-	case "true", "1", "yes":
-		return true, nil
-	case "false", "0", "no":
-		return false, nil
-	default:
-		return false, fmt.Errorf("expected true/false, got %q", s)
+// ConfigListCmd lists all configuration keys and their values.
+type ConfigListCmd struct {
+	Describe bool `help:"Include type, allowed values and description"`
+}
+
+// configListEntry is the JSON shape of one config list row.
+type configListEntry struct {
+	Key         string   `json:"key"`
+	Value       string   `json:"value,omitempty"`
+	Set         bool     `json:"set"`
+	Type        string   `json:"type,omitempty"`
+	Allowed     []string `json:"allowed,omitempty"`
+	Description string   `json:"description,omitempty"`
+}
+
+// Run prints every known key with its current value.
+func (c *ConfigListCmd) Run(flags *RootFlags) error {
+	cfg, err := config.ReadConfig()
+	if err != nil {
+		return fmt.Errorf("read config: %w", err)
+	}
+
+	headers := []string{"Key", "Value"}
+	if c.Describe {
+		headers = append(headers, "Type", "Allowed", "Description")
+	}
+
+	keys := config.Keys()
+	entries := make([]configListEntry, 0, len(keys))
+	rows := make([][]string, 0, len(keys))
+
+	for _, k := range keys {
+		value, ok := k.Get(&cfg)
+		entry := configListEntry{Key: k.Name, Value: value, Set: ok}
+
+		display := value
+		if !ok {
+			display = "-"
+		}
+
+		row := []string{k.Name, display}
+
+		if c.Describe {
+			entry.Type = string(k.Type)
+			entry.Allowed = k.Allowed
+			entry.Description = k.Description
+
+			allowed := strings.Join(k.Allowed, ", ")
+			if k.Type == config.KeyTypeBool {
+				allowed = "true, false"
+			}
+
+			row = append(row, string(k.Type), allowed, k.Description)
+		}
+
+		entries = append(entries, entry)
+		rows = append(rows, row)
+	}
+
+	f := newFormatter(flags)
+
+	return f.Output(entries, headers, rows)
+}
+
+// ConfigSchemaCmd prints the JSON Schema for config.yaml.
+type ConfigSchemaCmd struct{}
+
+// Run writes the JSON Schema to stdout.
+func (c *ConfigSchemaCmd) Run() error {
+	b, err := config.JSONSchema()
+	if err != nil {
+		return err
 	}
+
+	_, err = os.Stdout.Write(b)
+
+	return err
 }
 
 // ConfigPathCmd shows the config file path.
@@ -10,6 +10,7 @@ const (
 	CodeAuth      = 3
 	CodeAPI       = 4
 	CodeRateLimit = 5
+	CodeTie       = 6
 )
 
 type ExitError struct {
@@ -29,6 +29,7 @@ func TestExitCode_ExitError(t *testing.T) {
 		{"auth", CodeAuth, 3},
 		{"api", CodeAPI, 4},
 		{"rate-limit", CodeRateLimit, 5},
+		{"tie", CodeTie, 6},
 		{"negative", -1, 1},
 	}
 
@@ -10,7 +10,8 @@ import (
 
 	"github.com/alecthomas/kong"
 	"github.com/muesli/termenv"
-	"golang.org/x/term"
+
+	"github.com/dedene/strawpoll-cli/internal/output"
 )
 
 const (
@@ -181,17 +182,5 @@ func colorizeCommandLine(line string, cmdName, dim func(string) string) string {
 }
 
 func guessColumns(w io.Writer) int {
-	if cols := os.Getenv("COLUMNS"); cols != "" {
-		if n, err := strconv.Atoi(cols); err == nil {
-			return n
-		}
-	}
-
-	if f, ok := w.(*os.File); ok {
-		if width, _, err := term.GetSize(int(f.Fd())); err == nil && width > 0 {
-			return width
-		}
-	}
-
-	return 80
+	return output.TerminalWidth(w)
 }
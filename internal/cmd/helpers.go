@@ -1,10 +1,19 @@
 package cmd
 
 import (
+	"context"
+	"errors"
 	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"golang.org/x/term"
 
 	"github.com/dedene/strawpoll-cli/internal/api"
 	"github.com/dedene/strawpoll-cli/internal/auth"
+	"github.com/dedene/strawpoll-cli/internal/config"
+	"github.com/dedene/strawpoll-cli/internal/output"
 )
 
 // newClientFromAuth creates an API client using the stored API key.
@@ -14,5 +23,147 @@ func newClientFromAuth() (*api.Client, error) {
 		return nil, fmt.Errorf("authentication required: %w", err)
 	}
 
-	return api.NewClient(apiKey), nil
+	return newClient(apiKey), nil
+}
+
+// newClient creates an API client for apiBaseURL.
+func newClient(apiKey string) *api.Client {
+	client := api.NewClient(apiKey)
+	client.SetBaseURL(apiBaseURL())
+
+	return client
+}
+
+// apiBaseURL returns $STRAWPOLL_BASE_URL, else the public API endpoint.
+func apiBaseURL() string {
+	if u := os.Getenv("STRAWPOLL_BASE_URL"); u != "" {
+		return u
+	}
+
+	return api.DefaultBaseURL
+}
+
+// newFormatter creates a stdout formatter for the resolved --output mode,
+// --template, --fields, table width and --accessible flags. Table output
+// goes through the pager, which is flushed when the command returns.
+func newFormatter(flags *RootFlags) *output.Formatter {
+	f := output.NewFormatterMode(stdout(flags), flags.OutputMode(), flags.NoColor)
+	f.Template = flags.template
+	f.Fields = flags.Fields
+	f.Table = output.TableOptions{MaxColWidth: flags.MaxColWidth, Wrap: flags.Wrap}
+	f.Accessible = flags.Accessible
+
+	if !flags.Wide {
+		f.Table.Width = output.TableWidth(os.Stdout)
+	}
+
+	return f
+}
+
+// stdout returns the writer for command output: a pager shared by every
+// formatter of the command when table output goes to a terminal, else
+// os.Stdout.
+func stdout(flags *RootFlags) io.Writer {
+	if flags.pager != nil {
+		return flags.pager
+	}
+
+	if flags.NoPager || flags.OutputMode() != output.ModeTable || flags.template != nil {
+		return os.Stdout
+	}
+
+	height := output.TerminalHeight(os.Stdout)
+	if height == 0 || !term.IsTerminal(int(os.Stdout.Fd())) {
+		return os.Stdout
+	}
+
+	cfg, _ := config.ReadConfig()
+
+	command := output.PagerCommand(cfg.Pager)
+	if command == "" {
+		return os.Stdout
+	}
+
+	flags.pager = output.NewPager(os.Stdout, command, height)
+
+	return flags.pager
+}
+
+// createdCell formats a creation timestamp for list columns.
+func createdCell(unix int64) string {
+	return output.Times().Date(time.Unix(unix, 0))
+}
+
+// closesCell formats a poll's deadline for list columns, or "-" without one.
+func closesCell(p *api.Poll) string {
+	if p.PollConfig == nil || p.PollConfig.DeadlineAt == nil {
+		return "-"
+	}
+
+	return output.Times().Closes(time.Unix(*p.PollConfig.DeadlineAt, 0))
+}
+
+// pollTimes returns the Created and, when set, Deadline pairs of a poll.
+func pollTimes(p *api.Poll) [][2]string {
+	tf := output.Times()
+	pairs := [][2]string{{"Created", tf.DateTime(time.Unix(p.CreatedAt, 0))}}
+
+	if p.PollConfig != nil && p.PollConfig.DeadlineAt != nil {
+		pairs = append(pairs, [2]string{"Deadline", tf.Deadline(time.Unix(*p.PollConfig.DeadlineAt, 0))})
+	}
+
+	return pairs
+}
+
+// newWriteClient creates an API client for mutating commands.
+// With --dry-run, requests are printed instead of sent and a missing
+// API key is tolerated so requests can be previewed before setup.
+func newWriteClient(flags *RootFlags) (*api.Client, error) {
+	if !flags.DryRun {
+		return newClientFromAuth()
+	}
+
+	apiKey, err := auth.GetAPIKey()
+	if err != nil && !errors.Is(err, auth.ErrNoAPIKey) {
+		return nil, fmt.Errorf("authentication required: %w", err)
+	}
+
+	client := newClient(apiKey)
+	client.SetDryRun(func(req *api.DryRunRequest) error {
+		return printDryRun(req, flags)
+	})
+
+	return client, nil
+}
+
+// fetchForUpdate reads the poll whose options an update command replaces.
+// The read is sent even with --dry-run, so it still needs an API key.
+func fetchForUpdate(ctx context.Context, client *api.Client, flags *RootFlags, id string) (*api.Poll, error) {
+	poll, err := client.GetPoll(ctx, id)
+	if err == nil {
+		return poll, nil
+	}
+
+	if flags.DryRun {
+		return nil, fmt.Errorf("fetch poll for option changes (--dry-run still reads the poll to build the new option list): %w", err)
+	}
+
+	return nil, fmt.Errorf("fetch poll for option changes: %w", err)
+}
+
+// printDryRun writes the request that would have been sent to stdout:
+// through the formatter in structured modes and with --template, else as
+// "METHOD URL" followed by the JSON body.
+func printDryRun(req *api.DryRunRequest, flags *RootFlags) error {
+	if flags.template != nil || flags.OutputMode().Structured() {
+		return newFormatter(flags).Output(req, nil, nil)
+	}
+
+	fmt.Fprintf(os.Stdout, "%s %s\n", req.Method, req.URL)
+
+	if req.Body != nil {
+		return output.WriteJSON(os.Stdout, req.Body)
+	}
+
+	return nil
 }
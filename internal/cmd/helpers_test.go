@@ -0,0 +1,92 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/dedene/strawpoll-cli/internal/api"
+)
+
+func TestNewClientFromAuth_BaseURL(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v3/polls/abc" {
+			t.Errorf("path = %s, want /v3/polls/abc", r.URL.Path)
+		}
+
+		if got := r.Header.Get("X-API-Key"); got != "secret" {
+			t.Errorf("X-API-Key = %q, want secret", got)
+		}
+
+		_, _ = w.Write([]byte(`{"id":"abc","title":"Lunch"}`))
+	}))
+	defer srv.Close()
+
+	t.Setenv("STRAWPOLL_API_KEY", "secret")
+	t.Setenv("STRAWPOLL_BASE_URL", srv.URL+"/v3/")
+
+	client, err := newClientFromAuth()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer client.Close()
+
+	if client.BaseURL() != srv.URL+"/v3" {
+		t.Errorf("BaseURL = %q, want %q", client.BaseURL(), srv.URL+"/v3")
+	}
+
+	poll, err := client.GetPoll(context.Background(), "abc")
+	if err != nil {
+		t.Fatalf("GetPoll error: %v", err)
+	}
+
+	if poll.Title != "Lunch" {
+		t.Errorf("Title = %q, want Lunch", poll.Title)
+	}
+}
+
+func TestNewWriteClient_DryRunBaseURL(t *testing.T) {
+	var reads int
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			t.Errorf("dry run sent %s %s", r.Method, r.URL.Path)
+		}
+
+		reads++
+
+		_, _ = w.Write([]byte(`{"id":"abc"}`))
+	}))
+	defer srv.Close()
+
+	t.Setenv("STRAWPOLL_API_KEY", "secret")
+	t.Setenv("STRAWPOLL_BASE_URL", srv.URL)
+
+	client, err := newWriteClient(&RootFlags{DryRun: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer client.Close()
+
+	var printed *api.DryRunRequest
+
+	client.SetDryRun(func(req *api.DryRunRequest) error {
+		printed = req
+		return nil
+	})
+
+	// The pre-fetch of an update reads from the configured endpoint.
+	if _, err := client.GetPoll(context.Background(), "abc"); err != nil || reads != 1 {
+		t.Fatalf("GetPoll = %v after %d reads, want one read", err, reads)
+	}
+
+	if err := client.DeletePoll(context.Background(), "abc"); !errors.Is(err, api.ErrDryRun) {
+		t.Fatalf("DeletePoll error = %v, want ErrDryRun", err)
+	}
+
+	if printed == nil || printed.URL != srv.URL+"/polls/abc" {
+		t.Errorf("dry-run request = %+v, want URL %s/polls/abc", printed, srv.URL)
+	}
+}
@@ -11,7 +11,6 @@ import (
 	"github.com/pkg/browser"
 
 	"github.com/dedene/strawpoll-cli/internal/api"
-	"github.com/dedene/strawpoll-cli/internal/output"
 	"github.com/dedene/strawpoll-cli/internal/tui"
 )
 
@@ -106,8 +105,11 @@ func (c *MeetingCreateCmd) createFromFlags(flags *RootFlags) error {
 	}
 
 	req := c.buildRequest(options, loc)
+	if err := api.ValidateCreatePollRequest(req, time.Now()); err != nil {
+		return &ExitError{Code: CodeUsage, Err: err}
+	}
 
-	client, err := newClientFromAuth()
+	client, err := newWriteClient(flags)
 	if err != nil {
 		return err
 	}
@@ -121,7 +123,7 @@ func (c *MeetingCreateCmd) createFromFlags(flags *RootFlags) error {
 
 	pollURL := pollBaseURL + poll.ID
 
-	f := output.NewFormatter(os.Stdout, flags.JSON, flags.Plain, flags.NoColor)
+	f := newFormatter(flags)
 	if err := f.OutputSingle(poll, [][2]string{
 		{"ID", poll.ID},
 		{"Title", poll.Title},
@@ -16,10 +16,10 @@ type MeetingDeleteCmd struct {
 }
 
 // Run deletes a meeting poll, prompting for confirmation unless --force.
-func (c *MeetingDeleteCmd) Run(_ *RootFlags) error {
+func (c *MeetingDeleteCmd) Run(flags *RootFlags) error {
 	id := api.ParsePollID(c.ID)
 
-	if !c.Force {
+	if !c.Force && !flags.DryRun {
 		confirmed, err := tui.Confirm(fmt.Sprintf("Delete meeting poll %s? This cannot be undone.", id))
 		if err != nil {
 			return err
@@ -32,7 +32,7 @@ func (c *MeetingDeleteCmd) Run(_ *RootFlags) error {
 		}
 	}
 
-	client, err := newClientFromAuth()
+	client, err := newWriteClient(flags)
 	if err != nil {
 		return err
 	}
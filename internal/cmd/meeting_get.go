@@ -3,11 +3,9 @@ package cmd
 import (
 	"context"
 	"fmt"
-	"os"
 	"time"
 
 	"github.com/dedene/strawpoll-cli/internal/api"
-	"github.com/dedene/strawpoll-cli/internal/output"
 )
 
 // MeetingGetCmd retrieves meeting poll details.
@@ -32,15 +30,15 @@ func (c *MeetingGetCmd) Run(flags *RootFlags) error {
 
 	loc := meetingLocation(poll)
 
-	f := output.NewFormatter(os.Stdout, flags.JSON, flags.Plain, flags.NoColor)
-	return f.OutputSingle(poll, [][2]string{
+	f := newFormatter(flags)
+	return f.OutputSingle(poll, append([][2]string{
 		{"ID", poll.ID},
 		{"Title", poll.Title},
 		{"Location", meetingLocationStr(poll)},
 		{"Timezone", meetingTimezoneStr(poll)},
 		{"Options", formatMeetingOptions(poll, loc)},
 		{"Votes", voteCount(poll)},
-	})
+	}, pollTimes(poll)...))
 }
 
 // meetingLocation returns the *time.Location for the poll timezone.
@@ -78,39 +76,10 @@ func formatMeetingOptions(p *api.Poll, loc *time.Location) string {
 	}
 
 	// Show count + first option as preview
-	first := formatMeetingOption(p.PollOptions[0], loc)
+	first := formatTimeslot(p.PollOptions[0], loc)
 	if n == 1 {
 		return first
 	}
 
 	return fmt.Sprintf("%s (+%d more)", first, n-1)
 }
-
-// formatMeetingOption returns a human-readable time slot string.
-func formatMeetingOption(opt *api.PollOption, loc *time.Location) string {
-	switch opt.Type {
-	case api.OptionTypeDate:
-		if opt.Date != "" {
-			if t, err := time.Parse("2006-01-02", opt.Date); err == nil {
-				return t.Format("Mon Jan 2")
-			}
-		}
-
-		return opt.Value
-	case api.OptionTypeTimeRange:
-		if opt.StartTime != nil && opt.EndTime != nil {
-			start := time.Unix(*opt.StartTime, 0).In(loc)
-			end := time.Unix(*opt.EndTime, 0).In(loc)
-
-			return fmt.Sprintf("%s %s-%s",
-				start.Format("Mon Jan 2"),
-				start.Format("15:04"),
-				end.Format("15:04"),
-			)
-		}
-
-		return opt.Value
-	default:
-		return opt.Value
-	}
-}
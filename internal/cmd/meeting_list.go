@@ -4,10 +4,8 @@ import (
 	"context"
 	"fmt"
 	"os"
-	"time"
 
 	"github.com/dedene/strawpoll-cli/internal/api"
-	"github.com/dedene/strawpoll-cli/internal/output"
 )
 
 // MeetingListCmd lists the user's meeting polls.
@@ -43,8 +41,8 @@ func (c *MeetingListCmd) Run(flags *RootFlags) error {
 		return nil
 	}
 
-	f := output.NewFormatter(os.Stdout, flags.JSON, flags.Plain, flags.NoColor)
-	headers := []string{"ID", "Title", "Location", "Options", "Votes", "Created"}
+	f := newFormatter(flags)
+	headers := []string{"ID", "Title", "Location", "Options", "Votes", "Created", "Closes"}
 	rows := make([][]string, 0, len(meetings))
 
 	for i := range meetings {
@@ -55,7 +53,8 @@ func (c *MeetingListCmd) Run(flags *RootFlags) error {
 			meetingLocationStr(p),
 			fmt.Sprintf("%d", len(p.PollOptions)),
 			voteCount(p),
-			time.Unix(p.CreatedAt, 0).Format("2006-01-02"),
+			createdCell(p.CreatedAt),
+			closesCell(p),
 		})
 	}
 
@@ -15,9 +15,12 @@ import (
 type MeetingResultsCmd struct {
 	ID            string `arg:"" required:"" help:"Poll ID or URL"`
 	OriginalOrder bool   `help:"Show timeslots in original poll order instead of best availability first" name:"original-order"`
+
+	WinnerFlags `embed:""`
 }
 
-// Run fetches meeting poll and results, renders availability grid.
+// Run fetches meeting poll and results, renders availability grid with the
+// winning timeslots.
 func (c *MeetingResultsCmd) Run(flags *RootFlags) error {
 	id := api.ParsePollID(c.ID)
 
@@ -50,14 +53,28 @@ func (c *MeetingResultsCmd) Run(flags *RootFlags) error {
 		}
 	}
 
-	f := output.NewFormatter(os.Stdout, flags.JSON, flags.Plain, flags.NoColor)
+	f := newFormatter(flags)
 
 	headers, rows, scores := availabilityGrid(poll, results, loc)
+
+	// Timeslots win on availability, yes votes first; the score already
+	// holds the yes count, so there is no finer first preference.
+	winners, err := c.decide(poll, poll.PollOptions, intScores(scores), nil)
+	if err != nil {
+		return err
+	}
+
+	headers, rows = withWinners(headers, rows, optionIndex(len(rows)), winners)
+
 	if !c.OriginalOrder {
 		sortRowsByScore(rows, scores)
 	}
 
-	return f.Output(results, headers, rows)
+	if err := f.OutputGrid(&pollResultsJSON{PollResults: results, winnersJSON: winners}, headers, rows, "Name"); err != nil {
+		return err
+	}
+
+	return c.check(winners)
 }
 
 // availabilityGrid builds a timeslot-by-participant grid table.
@@ -101,27 +118,27 @@ func availabilityGrid(poll *api.Poll, results *api.PollResults, loc *time.Locati
 	return headers, rows, scores
 }
 
-// formatTimeslot formats a meeting poll option as a human-readable timeslot header.
+// formatTimeslot formats a meeting poll option as a human-readable timeslot
+// header in the configured time format:
 // - date options: "Mon Jan 2" (e.g. "Tue Aug 13")
 // - time_range options: "Mon Jan 2 15:04-15:04" (e.g. "Tue Aug 13 10:00-11:00")
 func formatTimeslot(opt *api.PollOption, loc *time.Location) string {
+	tf := output.Times()
+
 	switch opt.Type {
 	case api.OptionTypeDate:
 		t, err := time.Parse("2006-01-02", opt.Date)
 		if err != nil {
 			return opt.Value
 		}
-		return t.Format("Mon Jan 2")
+		return tf.Day(t)
 
 	case api.OptionTypeTimeRange:
 		if opt.StartTime == nil || opt.EndTime == nil {
 			return opt.Value
 		}
 
-		start := time.Unix(*opt.StartTime, 0).In(loc)
-		end := time.Unix(*opt.EndTime, 0).In(loc)
-
-		return start.Format("Mon Jan 2 15:04") + "-" + end.Format("15:04")
+		return tf.Slot(time.Unix(*opt.StartTime, 0).In(loc), time.Unix(*opt.EndTime, 0).In(loc))
 
 	default:
 		return opt.Value
@@ -7,7 +7,6 @@ import (
 	"time"
 
 	"github.com/dedene/strawpoll-cli/internal/api"
-	"github.com/dedene/strawpoll-cli/internal/output"
 )
 
 // MeetingUpdateCmd updates an existing meeting poll.
@@ -28,7 +27,7 @@ func (c *MeetingUpdateCmd) Run(flags *RootFlags) error {
 
 	id := api.ParsePollID(c.ID)
 
-	client, err := newClientFromAuth()
+	client, err := newWriteClient(flags)
 	if err != nil {
 		return err
 	}
@@ -60,9 +59,9 @@ func (c *MeetingUpdateCmd) Run(flags *RootFlags) error {
 
 	// Add new date/range options -- fetch existing to determine next position.
 	if len(c.AddDate) > 0 || len(c.AddRange) > 0 {
-		poll, err := client.GetPoll(ctx, id)
+		poll, err := fetchForUpdate(ctx, client, flags, id)
 		if err != nil {
-			return fmt.Errorf("fetch poll for option additions: %w", err)
+			return err
 		}
 
 		// Resolve timezone for time range parsing.
@@ -99,6 +98,10 @@ func (c *MeetingUpdateCmd) Run(flags *RootFlags) error {
 		req.PollOptions = opts
 	}
 
+	if err := api.ValidateUpdatePollRequest(req, time.Now()); err != nil {
+		return &ExitError{Code: CodeUsage, Err: err}
+	}
+
 	poll, err := client.UpdatePoll(ctx, id, req)
 	if err != nil {
 		return err
@@ -107,17 +110,17 @@ func (c *MeetingUpdateCmd) Run(flags *RootFlags) error {
 	pollURL := pollBaseURL + poll.ID
 	loc := meetingLocation(poll)
 
-	f := output.NewFormatter(os.Stdout, flags.JSON, flags.Plain, flags.NoColor)
+	f := newFormatter(flags)
 	fmt.Fprintf(os.Stderr, "Meeting poll updated: %s\n", pollURL)
 
-	return f.OutputSingle(poll, [][2]string{
+	return f.OutputSingle(poll, append([][2]string{
 		{"ID", poll.ID},
 		{"Title", poll.Title},
 		{"Location", meetingLocationStr(poll)},
 		{"Timezone", meetingTimezoneStr(poll)},
 		{"Options", formatMeetingOptions(poll, loc)},
 		{"Votes", voteCount(poll)},
-	})
+	}, pollTimes(poll)...))
 }
 
 // resolveUpdateTimezone picks timezone for parsing new time ranges:
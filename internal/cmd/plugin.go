@@ -0,0 +1,247 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/dedene/strawpoll-cli/internal/auth"
+	"github.com/dedene/strawpoll-cli/internal/config"
+	"github.com/dedene/strawpoll-cli/internal/output"
+)
+
+// pluginPrefix is prepended to a command name to find its plugin executable.
+const pluginPrefix = "strawpoll-"
+
+// PluginCmd groups plugin management subcommands.
+type PluginCmd struct {
+	List PluginListCmd `cmd:"" help:"List plugins found on PATH"`
+}
+
+// PluginListCmd lists strawpoll-* executables on PATH.
+type PluginListCmd struct{}
+
+// pluginInfo describes a discovered plugin executable.
+type pluginInfo struct {
+	Name     string `json:"name"`
+	Path     string `json:"path"`
+	Shadowed bool   `json:"shadowed"`
+}
+
+// Run prints discovered plugins, flagging those hidden by a built-in command.
+func (c *PluginListCmd) Run(flags *RootFlags) error {
+	plugins := discoverPlugins(os.Getenv("PATH"), builtinCommands())
+
+	rows := make([][]string, 0, len(plugins))
+	for _, p := range plugins {
+		note := ""
+		if p.Shadowed {
+			note = "shadowed by built-in command"
+		}
+
+		rows = append(rows, []string{p.Name, p.Path, note})
+	}
+
+	f := newFormatter(flags)
+	if err := f.Output(plugins, []string{"Name", "Path", "Note"}, rows); err != nil {
+		return err
+	}
+
+	if len(plugins) == 0 && f.Mode == output.ModeTable {
+		fmt.Fprintf(os.Stderr, "No plugins found. Plugins are executables named %s<name> on PATH.\n", pluginPrefix)
+	}
+
+	return nil
+}
+
+// discoverPlugins scans pathList for strawpoll-* executables.
+// Earlier PATH entries win when the same plugin name appears more than once.
+func discoverPlugins(pathList string, builtins []string) []pluginInfo {
+	seen := make(map[string]bool)
+
+	var plugins []pluginInfo
+
+	for _, dir := range filepath.SplitList(pathList) {
+		if dir == "" {
+			continue
+		}
+
+		entries, err := os.ReadDir(dir)
+		if err != nil {
+			continue
+		}
+
+		for _, e := range entries {
+			name, ok := pluginName(e.Name())
+			if !ok || seen[name] || e.IsDir() {
+				continue
+			}
+
+			path := filepath.Join(dir, e.Name())
+			if !isExecutable(path) {
+				continue
+			}
+
+			seen[name] = true
+			plugins = append(plugins, pluginInfo{
+				Name:     name,
+				Path:     path,
+				Shadowed: slices.Contains(builtins, name),
+			})
+		}
+	}
+
+	sort.Slice(plugins, func(i, j int) bool { return plugins[i].Name < plugins[j].Name })
+
+	return plugins
+}
+
+// pluginName returns the command name for a strawpoll-* file name.
+func pluginName(file string) (string, bool) {
+	if !strings.HasPrefix(file, pluginPrefix) {
+		return "", false
+	}
+
+	name := strings.TrimPrefix(file, pluginPrefix)
+	if runtime.GOOS == "windows" {
+		name = strings.TrimSuffix(name, filepath.Ext(name))
+	}
+
+	return name, name != ""
+}
+
+func isExecutable(path string) bool {
+	info, err := os.Stat(path)
+	if err != nil || info.IsDir() {
+		return false
+	}
+
+	if runtime.GOOS == "windows" {
+		switch strings.ToLower(filepath.Ext(path)) {
+		case ".exe", ".bat", ".cmd":
+			return true
+		default:
+			return false
+		}
+	}
+
+	return info.Mode()&0o111 != 0
+}
+
+// runPlugin executes strawpoll-<name> when args name an unknown command
+// with a matching executable on PATH. It reports whether a plugin handled args.
+func runPlugin(args []string, builtins []string) (bool, error) {
+	lead, rest := splitLeadingFlags(args)
+	if len(rest) == 0 || slices.Contains(builtins, rest[0]) {
+		return false, nil
+	}
+
+	path, err := exec.LookPath(pluginPrefix + rest[0])
+	if err != nil {
+		return false, nil //nolint:nilerr // not a plugin; let kong report the unknown command
+	}
+
+	cmd := exec.Command(path, rest[1:]...) //nolint:gosec // user-installed plugin
+	cmd.Stdin = os.Stdin
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	cmd.Env = append(os.Environ(), pluginEnv(lead)...)
+
+	if err := cmd.Run(); err != nil {
+		var exitErr *exec.ExitError
+		if errors.As(err, &exitErr) {
+			return true, &ExitError{Code: exitErr.ExitCode(), Err: fmt.Errorf("plugin %s exited with status %d", rest[0], exitErr.ExitCode())}
+		}
+
+		return true, fmt.Errorf("run plugin %s: %w", rest[0], err)
+	}
+
+	return true, nil
+}
+
+// pluginEnv builds the STRAWPOLL_* variables handed to plugins from the
+// global flags that preceded the plugin name. Only the flags are parsed:
+// the config, theme and other global state are left alone.
+func pluginEnv(lead []string) []string {
+	var (
+		jsonFlag, plain, dryRun bool
+		outputFlag              string
+	)
+
+	noColor := os.Getenv("NO_COLOR") != ""
+
+	for i := 0; i < len(lead); i++ {
+		switch f := lead[i]; {
+		case f == "--json" || f == "-j":
+			jsonFlag = true
+		case f == "--plain":
+			plain = true
+		case f == "--no-color":
+			noColor = true
+		case f == "--dry-run":
+			dryRun = true
+		case (f == "-o" || f == "--output") && i+1 < len(lead):
+			i++
+			outputFlag = lead[i]
+		case strings.HasPrefix(f, "--output="):
+			outputFlag = strings.TrimPrefix(f, "--output=")
+		case strings.HasPrefix(f, "-o") && !strings.HasPrefix(f, "--"):
+			outputFlag = strings.TrimPrefix(strings.TrimPrefix(f, "-o"), "=")
+		case valueFlags[f]:
+			i++
+		}
+	}
+
+	// Plugins validate their own flags; an unknown --output reports "table",
+	// and --output wins over --json and --plain as it does for commands.
+	mode := output.ModeFromFlags(jsonFlag, plain)
+	if outputFlag != "" {
+		if m, err := output.ParseMode(outputFlag); err == nil {
+			mode = m
+		} else {
+			mode = output.ModeTable
+		}
+	}
+
+	env := []string{
+		"STRAWPOLL_BASE_URL=" + apiBaseURL(),
+		"STRAWPOLL_OUTPUT=" + mode.String(),
+		"STRAWPOLL_PROFILE=" + activeProfile(),
+		"STRAWPOLL_VERSION=" + VersionString(),
+	}
+
+	if noColor {
+		env = append(env, "STRAWPOLL_NO_COLOR=1")
+	}
+
+	if dryRun {
+		env = append(env, "STRAWPOLL_DRY_RUN=1")
+	}
+
+	if path, err := config.ConfigPath(); err == nil {
+		env = append(env, "STRAWPOLL_CONFIG="+path)
+	}
+
+	if key, err := auth.GetAPIKey(); err == nil {
+		env = append(env, "STRAWPOLL_API_KEY="+key)
+	}
+
+	return env
+}
+
+// activeProfile names the profile plugins run under: $STRAWPOLL_PROFILE,
+// else "default". The CLI itself keeps one API key and config file, so
+// the name only lets plugins keep separate state per profile.
+func activeProfile() string {
+	if p := os.Getenv("STRAWPOLL_PROFILE"); p != "" {
+		return p
+	}
+
+	return "default"
+}
@@ -0,0 +1,127 @@
+package cmd
+
+import (
+	"os"
+	"path/filepath"
+	"runtime"
+	"slices"
+	"testing"
+)
+
+func writeExecutable(t *testing.T, dir, name string, mode os.FileMode) {
+	t.Helper()
+
+	if err := os.WriteFile(filepath.Join(dir, name), []byte("#!/bin/sh\n"), mode); err != nil {
+		t.Fatalf("write %s: %v", name, err)
+	}
+}
+
+func TestDiscoverPlugins(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("executable bits are not used on windows")
+	}
+
+	first := t.TempDir()
+	second := t.TempDir()
+
+	writeExecutable(t, first, "strawpoll-wiki", 0o755)
+	writeExecutable(t, first, "strawpoll-notexec", 0o644)
+	writeExecutable(t, first, "other-tool", 0o755)
+	writeExecutable(t, second, "strawpoll-wiki", 0o755)
+	writeExecutable(t, second, "strawpoll-poll", 0o755)
+
+	plugins := discoverPlugins(first+string(os.PathListSeparator)+second, []string{"poll"})
+
+	names := make([]string, len(plugins))
+	for i, p := range plugins {
+		names[i] = p.Name
+	}
+
+	if !slices.Equal(names, []string{"poll", "wiki"}) {
+		t.Fatalf("plugin names = %v, want [poll wiki]", names)
+	}
+
+	if !plugins[0].Shadowed {
+		t.Error("poll plugin should be shadowed by built-in command")
+	}
+
+	if want := filepath.Join(first, "strawpoll-wiki"); plugins[1].Path != want {
+		t.Errorf("wiki path = %q, want %q (first PATH entry wins)", plugins[1].Path, want)
+	}
+}
+
+func TestSplitLeadingFlags(t *testing.T) {
+	lead, rest := splitLeadingFlags([]string{"--json", "-o", "csv", "--no-color", "wiki", "--flag", "arg"})
+
+	if !slices.Equal(lead, []string{"--json", "-o", "csv", "--no-color"}) {
+		t.Errorf("lead = %v", lead)
+	}
+
+	if !slices.Equal(rest, []string{"wiki", "--flag", "arg"}) {
+		t.Errorf("rest = %v", rest)
+	}
+}
+
+func TestSplitLeadingFlags_AttachedValue(t *testing.T) {
+	lead, rest := splitLeadingFlags([]string{"-ocsv", "--output=yaml", "wiki", "arg"})
+
+	if !slices.Equal(lead, []string{"-ocsv", "--output=yaml"}) || !slices.Equal(rest, []string{"wiki", "arg"}) {
+		t.Errorf("lead = %v, rest = %v", lead, rest)
+	}
+}
+
+func TestPluginEnv(t *testing.T) {
+	t.Setenv("STRAWPOLL_API_KEY", "secret")
+	t.Setenv("NO_COLOR", "")
+
+	env := pluginEnv([]string{"--json"})
+
+	for _, want := range []string{"STRAWPOLL_API_KEY=secret", "STRAWPOLL_OUTPUT=json"} {
+		if !slices.Contains(env, want) {
+			t.Errorf("plugin env missing %q: %v", want, env)
+		}
+	}
+
+	env = pluginEnv([]string{"--json", "--output", "yaml"})
+	if !slices.Contains(env, "STRAWPOLL_OUTPUT=yaml") {
+		t.Errorf("--output should win over --json: %v", env)
+	}
+
+	tests := []struct {
+		lead []string
+		want string
+	}{
+		{[]string{"-ocsv"}, "csv"},
+		{[]string{"-o=ndjson"}, "ndjson"},
+		{[]string{"--output=md"}, "markdown"},
+		{[]string{"--plain", "-o", "bogus"}, "table"},
+	}
+
+	for _, tt := range tests {
+		if env := pluginEnv(tt.lead); !slices.Contains(env, "STRAWPOLL_OUTPUT="+tt.want) {
+			t.Errorf("pluginEnv(%v) output, want %s: %v", tt.lead, tt.want, env)
+		}
+	}
+}
+
+func TestPluginEnv_BaseURL(t *testing.T) {
+	t.Setenv("STRAWPOLL_BASE_URL", "http://localhost:8080/v3")
+
+	if env := pluginEnv(nil); !slices.Contains(env, "STRAWPOLL_BASE_URL=http://localhost:8080/v3") {
+		t.Errorf("plugin env should pass the configured base URL: %v", env)
+	}
+}
+
+func TestPluginEnv_Profile(t *testing.T) {
+	t.Setenv("STRAWPOLL_PROFILE", "")
+
+	if env := pluginEnv(nil); !slices.Contains(env, "STRAWPOLL_PROFILE=default") {
+		t.Errorf("plugin env should name the default profile: %v", env)
+	}
+
+	t.Setenv("STRAWPOLL_PROFILE", "work")
+
+	if env := pluginEnv(nil); !slices.Contains(env, "STRAWPOLL_PROFILE=work") {
+		t.Errorf("plugin env should pass the active profile: %v", env)
+	}
+}
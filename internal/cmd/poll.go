@@ -2,11 +2,17 @@ package cmd
 
 // PollCmd groups poll subcommands.
 type PollCmd struct {
-	Create  PollCreateCmd  `cmd:"" help:"Create a multiple-choice poll"`
-	Get     PollGetCmd     `cmd:"" help:"Get poll details"`
-	Results PollResultsCmd `cmd:"" help:"View poll results"`
-	Delete  PollDeleteCmd  `cmd:"" help:"Delete a poll"`
-	Update  PollUpdateCmd  `cmd:"" help:"Update a poll"`
-	Reset   PollResetCmd   `cmd:"" help:"Reset poll results"`
-	List    PollListCmd    `cmd:"" help:"List your polls"`
+	Create    PollCreateCmd    `cmd:"" help:"Create a multiple-choice poll"`
+	Get       PollGetCmd       `cmd:"" help:"Get poll details"`
+	Results   PollResultsCmd   `cmd:"" help:"View poll results"`
+	Watch     PollWatchCmd     `cmd:"" help:"Live-updating results dashboard"`
+	Export    PollExportCmd    `cmd:"" help:"Export results to CSV, Markdown, HTML or XLSX"`
+	Analytics PollAnalyticsCmd `cmd:"" help:"Show participant countries and the vote timeline"`
+	Audit     PollAuditCmd     `cmd:"" help:"Flag suspicious votes: duplicate names, bursts, ballot clusters, country spikes"`
+	Snapshot  PollSnapshotCmd  `cmd:"" help:"Save the current results locally for poll diff"`
+	Diff      PollDiffCmd      `cmd:"" help:"Show votes, participants and ballots changed since a snapshot"`
+	Delete    PollDeleteCmd    `cmd:"" help:"Delete a poll"`
+	Update    PollUpdateCmd    `cmd:"" help:"Update a poll"`
+	Reset     PollResetCmd     `cmd:"" help:"Reset poll results"`
+	List      PollListCmd      `cmd:"" help:"List your polls"`
 }
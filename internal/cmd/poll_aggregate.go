@@ -0,0 +1,185 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/dedene/strawpoll-cli/internal/api"
+)
+
+// fetchedPoll is one poll's results, with the poll itself when needed.
+type fetchedPoll struct {
+	results *api.PollResults
+	poll    *api.Poll
+}
+
+// fetchPolls fetches the results of several polls concurrently. All
+// requests share the client's rate limiter. The poll is fetched too when
+// withPoll is set. Errors name the poll when there is more than one.
+func fetchPolls(ctx context.Context, client *api.Client, ids []string, withPoll bool) ([]fetchedPoll, error) {
+	out := make([]fetchedPoll, len(ids))
+	errs := make([]error, len(ids))
+
+	var wg sync.WaitGroup
+
+	for i, id := range ids {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			results, err := client.GetPollResults(ctx, id)
+			if err == nil && withPoll {
+				out[i].poll, err = client.GetPoll(ctx, id)
+			}
+
+			out[i].results, errs[i] = results, err
+		}()
+	}
+
+	wg.Wait()
+
+	for i, err := range errs {
+		if err == nil {
+			continue
+		}
+
+		if len(ids) > 1 {
+			return nil, fmt.Errorf("poll %s: %w", ids[i], err)
+		}
+
+		return nil, err
+	}
+
+	return out, nil
+}
+
+// pollBreakdownJSON is one poll's share of aggregated results.
+type pollBreakdownJSON struct {
+	ID               string `json:"id"`
+	VoteCount        int    `json:"voteCount"`
+	ParticipantCount int    `json:"participantCount"`
+	// Votes holds the poll's votes per combined option; options the poll
+	// does not have are left out.
+	Votes map[string]int `json:"votes"`
+	// counts holds the votes per combined option, -1 when the poll does not
+	// have the option.
+	counts []int
+}
+
+// optionKey matches options across polls by value, ignoring case and
+// surrounding spaces.
+func optionKey(value string) string {
+	return strings.ToLower(strings.TrimSpace(value))
+}
+
+// checkPollTypes returns a usage error when the polls are not all of the
+// same type, since votes of different poll types cannot be combined.
+func checkPollTypes(polls []fetchedPoll) error {
+	for _, fp := range polls[1:] {
+		if fp.poll.Type != polls[0].poll.Type {
+			return &ExitError{Code: CodeUsage, Err: fmt.Errorf("cannot aggregate %s poll %s with %s poll %s",
+				polls[0].poll.Type, polls[0].poll.ID, fp.poll.Type, fp.poll.ID)}
+		}
+	}
+
+	return nil
+}
+
+// aggregateResults merges polls into combined results. Options sharing a
+// value are combined, in order of first appearance, and participants'
+// votes are renumbered to the combined options.
+func aggregateResults(polls []fetchedPoll) (*api.PollResults, []pollBreakdownJSON) {
+	merged := &api.PollResults{}
+	index := make(map[string]int)
+	breakdown := make([]pollBreakdownJSON, len(polls))
+	ids := make([]string, len(polls))
+
+	for p, fp := range polls {
+		r := fp.results
+		ids[p] = r.ID
+		remap := make([]int, len(r.PollOptions))
+
+		for i, opt := range r.PollOptions {
+			key := optionKey(opt.Value)
+
+			j, ok := index[key]
+			if !ok {
+				j = len(merged.PollOptions)
+				index[key] = j
+				merged.PollOptions = append(merged.PollOptions, &api.PollOption{
+					Type: opt.Type, Value: opt.Value, Position: j,
+				})
+			}
+
+			merged.PollOptions[j].VoteCount += opt.VoteCount
+			remap[i] = j
+		}
+
+		merged.VoteCount += r.VoteCount
+		merged.ParticipantCount += r.ParticipantCount
+
+		for _, part := range r.PollParticipants {
+			moved := *part
+			moved.PollVotes = make([]*int, 0, len(part.PollVotes))
+
+			for _, v := range part.PollVotes {
+				if v != nil && *v >= 0 && *v < len(remap) {
+					j := remap[*v]
+					moved.PollVotes = append(moved.PollVotes, &j)
+				}
+			}
+
+			merged.PollParticipants = append(merged.PollParticipants, &moved)
+		}
+
+		breakdown[p] = pollBreakdownJSON{
+			ID:               r.ID,
+			VoteCount:        r.VoteCount,
+			ParticipantCount: r.ParticipantCount,
+			Votes:            make(map[string]int, len(r.PollOptions)),
+		}
+	}
+
+	merged.ID = strings.Join(ids, ",")
+
+	for p, fp := range polls {
+		b := &breakdown[p]
+		b.counts = make([]int, len(merged.PollOptions))
+
+		for j := range b.counts {
+			b.counts[j] = -1
+		}
+
+		for _, opt := range fp.results.PollOptions {
+			j := index[optionKey(opt.Value)]
+			b.counts[j] = max(b.counts[j], 0) + opt.VoteCount
+			b.Votes[merged.PollOptions[j].Value] = b.counts[j]
+		}
+	}
+
+	return merged, breakdown
+}
+
+// withBreakdown appends one column per poll with its votes for each
+// option, "-" where the poll lacks the option. index maps each row to its
+// combined option.
+func withBreakdown(headers []string, rows [][]string, index []int, breakdown []pollBreakdownJSON) ([]string, [][]string) {
+	for _, b := range breakdown {
+		headers = append(headers, b.ID)
+
+		for r, i := range index {
+			cell := "-"
+			if b.counts[i] >= 0 {
+				cell = strconv.Itoa(b.counts[i])
+			}
+
+			rows[r] = append(rows[r], cell)
+		}
+	}
+
+	return headers, rows
+}
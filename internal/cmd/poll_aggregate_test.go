@@ -0,0 +1,235 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/dedene/strawpoll-cli/internal/api"
+)
+
+// aggregateFixture returns results with one participant per ballot, each a
+// list of option indices.
+func aggregateFixture(id string, values []string, ballots ...[]int) fetchedPoll {
+	r := statsFixture(values, ballots)
+	r.ID = id
+
+	return fetchedPoll{results: r, poll: &api.Poll{ID: id, Type: api.PollTypeMultipleChoice}}
+}
+
+func TestAggregateResults(t *testing.T) {
+	tests := []struct {
+		name      string
+		polls     []fetchedPoll
+		values    []string
+		counts    []int
+		breakdown [][]int
+		ballots   [][]int
+	}{
+		{
+			name: "same options",
+			polls: []fetchedPoll{
+				aggregateFixture("p1", []string{"Pizza", "Sushi"}, []int{0}, []int{1}),
+				aggregateFixture("p2", []string{"Pizza", "Sushi"}, []int{1}),
+			},
+			values:    []string{"Pizza", "Sushi"},
+			counts:    []int{1, 2},
+			breakdown: [][]int{{1, 1}, {0, 1}},
+			ballots:   [][]int{{0}, {1}, {1}},
+		},
+		{
+			name: "merged by case and whitespace",
+			polls: []fetchedPoll{
+				aggregateFixture("p1", []string{"Pizza", "Sushi"}, []int{0, 1}),
+				aggregateFixture("p2", []string{" sushi ", "PIZZA"}, []int{0}, []int{1}),
+			},
+			values:    []string{"Pizza", "Sushi"},
+			counts:    []int{2, 2},
+			breakdown: [][]int{{1, 1}, {1, 1}},
+			ballots:   [][]int{{0, 1}, {1}, {0}},
+		},
+		{
+			name: "missing options",
+			polls: []fetchedPoll{
+				aggregateFixture("p1", []string{"Pizza", "Sushi"}, []int{1}),
+				aggregateFixture("p2", []string{"Tacos", "Pizza"}, []int{0}, []int{1}),
+			},
+			values:    []string{"Pizza", "Sushi", "Tacos"},
+			counts:    []int{1, 1, 1},
+			breakdown: [][]int{{0, 1, -1}, {1, -1, 1}},
+			ballots:   [][]int{{1}, {2}, {0}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			merged, breakdown := aggregateResults(tt.polls)
+
+			var values []string
+
+			var counts []int
+
+			for _, opt := range merged.PollOptions {
+				values = append(values, opt.Value)
+				counts = append(counts, opt.VoteCount)
+			}
+
+			if !reflect.DeepEqual(values, tt.values) || !reflect.DeepEqual(counts, tt.counts) {
+				t.Errorf("options = %v %v, want %v %v", values, counts, tt.values, tt.counts)
+			}
+
+			if merged.ID != "p1,p2" || merged.ParticipantCount != len(tt.ballots) {
+				t.Errorf("ID = %q, ParticipantCount = %d", merged.ID, merged.ParticipantCount)
+			}
+
+			for p, b := range breakdown {
+				if !reflect.DeepEqual(b.counts, tt.breakdown[p]) {
+					t.Errorf("breakdown %s = %v, want %v", b.ID, b.counts, tt.breakdown[p])
+				}
+
+				for j, n := range tt.breakdown[p] {
+					got, ok := b.Votes[tt.values[j]]
+					if ok != (n >= 0) || (ok && got != n) {
+						t.Errorf("breakdown %s Votes[%s] = %d, %v, want %d", b.ID, tt.values[j], got, ok, n)
+					}
+				}
+			}
+
+			var ballots [][]int
+
+			for _, part := range merged.PollParticipants {
+				var ballot []int
+				for _, v := range part.PollVotes {
+					ballot = append(ballot, *v)
+				}
+
+				ballots = append(ballots, ballot)
+			}
+
+			if !reflect.DeepEqual(ballots, tt.ballots) {
+				t.Errorf("ballots = %v, want %v", ballots, tt.ballots)
+			}
+		})
+	}
+}
+
+func TestAggregateResults_KeepsInput(t *testing.T) {
+	polls := []fetchedPoll{
+		aggregateFixture("p1", []string{"Pizza"}, []int{0}),
+		aggregateFixture("p2", []string{"Sushi", "Pizza"}, []int{1}),
+	}
+
+	aggregateResults(polls)
+
+	if v := *polls[1].results.PollParticipants[0].PollVotes[0]; v != 1 {
+		t.Errorf("source vote = %d, want 1", v)
+	}
+}
+
+func TestWithBreakdown(t *testing.T) {
+	_, breakdown := aggregateResults([]fetchedPoll{
+		aggregateFixture("p1", []string{"Pizza", "Sushi"}, []int{0}, []int{0}),
+		aggregateFixture("p2", []string{"Tacos", "Pizza"}, []int{0}),
+	})
+
+	// Rows in a different order than the combined options.
+	headers, rows := withBreakdown(
+		[]string{"Option", "Votes"},
+		[][]string{{"Tacos", "1"}, {"Pizza", "2"}, {"Sushi", "0"}},
+		[]int{2, 0, 1},
+		breakdown,
+	)
+
+	if want := []string{"Option", "Votes", "p1", "p2"}; !reflect.DeepEqual(headers, want) {
+		t.Errorf("headers = %v, want %v", headers, want)
+	}
+
+	want := [][]string{{"Tacos", "1", "-", "1"}, {"Pizza", "2", "2", "0"}, {"Sushi", "0", "0", "-"}}
+	if !reflect.DeepEqual(rows, want) {
+		t.Errorf("rows = %v, want %v", rows, want)
+	}
+}
+
+func TestCheckPollTypes(t *testing.T) {
+	polls := []fetchedPoll{
+		aggregateFixture("p1", []string{"Pizza"}),
+		aggregateFixture("p2", []string{"Pizza"}),
+	}
+
+	if err := checkPollTypes(polls); err != nil {
+		t.Errorf("same types: %v", err)
+	}
+
+	polls = append(polls, fetchedPoll{poll: &api.Poll{ID: "p3", Type: api.PollTypeRanking}})
+
+	err := checkPollTypes(polls)
+
+	var exit *ExitError
+	if !errors.As(err, &exit) || exit.Code != CodeUsage || !strings.Contains(err.Error(), "p3") {
+		t.Errorf("mixed types error = %v, want a usage error naming p3", err)
+	}
+}
+
+func TestFetchPolls_Errors(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if strings.HasPrefix(r.URL.Path, "/polls/missing") {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"error":{"message":"Poll not found","code":404}}`))
+
+			return
+		}
+
+		_, _ = w.Write([]byte(`{"id":"ok"}`))
+	}))
+	defer srv.Close()
+
+	client := api.NewClient("test-api-key")
+	client.SetBaseURL(srv.URL)
+
+	defer client.Close()
+
+	tests := []struct {
+		name     string
+		ids      []string
+		withPoll bool
+		wantErr  bool
+		named    bool
+	}{
+		{name: "all found", ids: []string{"ok", "ok"}, withPoll: true},
+		{name: "several polls", ids: []string{"ok", "missing", "ok"}, wantErr: true, named: true},
+		{name: "several polls with poll", ids: []string{"ok", "missing"}, withPoll: true, wantErr: true, named: true},
+		{name: "single poll", ids: []string{"missing"}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			polls, err := fetchPolls(context.Background(), client, tt.ids, tt.withPoll)
+
+			if !tt.wantErr {
+				if err != nil {
+					t.Fatalf("fetchPolls error: %v", err)
+				}
+
+				for _, p := range polls {
+					if p.results == nil || (p.poll == nil) == tt.withPoll {
+						t.Errorf("fetched %+v, withPoll %v", p, tt.withPoll)
+					}
+				}
+
+				return
+			}
+
+			if err == nil {
+				t.Fatal("fetchPolls: want error")
+			}
+
+			if got := strings.HasPrefix(err.Error(), "poll missing: "); got != tt.named {
+				t.Errorf("error = %q, want poll named %v", err, tt.named)
+			}
+		})
+	}
+}
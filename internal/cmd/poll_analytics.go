@@ -0,0 +1,344 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/dedene/strawpoll-cli/internal/api"
+	"github.com/dedene/strawpoll-cli/internal/output"
+)
+
+// PollAnalyticsCmd summarizes who voted from where, and when.
+type PollAnalyticsCmd struct {
+	ID       string `arg:"" required:"" help:"Poll ID or URL"`
+	Bucket   string `help:"Timeline bucket size: hour, day, or auto (hourly for votes spanning up to two days); hour falls back to day beyond two weeks" default:"auto" enum:"auto,hour,day"`
+	Timeline bool   `help:"List the vote count of every timeline bucket"`
+}
+
+// analyticsJSON is the structured output of poll analytics.
+type analyticsJSON struct {
+	ID           string     `json:"id"`
+	Title        string     `json:"title"`
+	Participants int        `json:"participants"`
+	CreatedAt    time.Time  `json:"createdAt"`
+	FirstVoteAt  *time.Time `json:"firstVoteAt,omitempty"`
+	LastVoteAt   *time.Time `json:"lastVoteAt,omitempty"`
+	// TimeToFirstVote is the delay between creation and the first vote, in
+	// seconds.
+	TimeToFirstVote *int64 `json:"timeToFirstVote,omitempty"`
+	// LastChangeAt is when the poll was last edited or reset; votes cast
+	// since then saw the poll as it is now.
+	LastChangeAt     *time.Time      `json:"lastChangeAt,omitempty"`
+	VotesSinceChange int             `json:"votesSinceChange"`
+	ShareSinceChange float64         `json:"shareSinceChange"`
+	Countries        []countryJSON   `json:"countries"`
+	Bucket           string          `json:"bucket"`
+	Timeline         []timelineEntry `json:"timeline"`
+}
+
+type countryJSON struct {
+	Code         string  `json:"code"`
+	Participants int     `json:"participants"`
+	Percentage   float64 `json:"percentage"`
+}
+
+type timelineEntry struct {
+	Start time.Time `json:"start"`
+	Votes int       `json:"votes"`
+}
+
+// Run fetches a poll and its participants and prints the analytics.
+func (c *PollAnalyticsCmd) Run(flags *RootFlags) error {
+	id := api.ParsePollID(c.ID)
+
+	client, err := newClientFromAuth()
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	ctx := context.Background()
+
+	poll, err := client.GetPoll(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	results, err := client.GetPollResults(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if len(results.PollParticipants) == 0 && results.ParticipantCount > 0 {
+		fmt.Fprintln(os.Stderr, "warning: participants are hidden for this poll; analytics are empty")
+	}
+
+	a := buildAnalytics(poll, results, c.Bucket, time.Local, os.Stderr)
+	f := newFormatter(flags)
+
+	if f.UsesValue() {
+		return f.Output(a, nil, nil)
+	}
+
+	if err := f.OutputSingle(a, analyticsSummary(a, f)); err != nil {
+		return err
+	}
+
+	fmt.Fprintln(f.Writer)
+
+	headers, rows := countryTable(a)
+	if err := f.Output(a, headers, rows); err != nil {
+		return err
+	}
+
+	if !c.Timeline {
+		return nil
+	}
+
+	fmt.Fprintln(f.Writer)
+
+	headers, rows = timelineTable(a)
+
+	return f.Output(a, headers, rows)
+}
+
+// maxHourlySpan caps hourly buckets, which would otherwise list thousands
+// of mostly empty hours for polls open for months.
+const maxHourlySpan = 14 * 24 * time.Hour
+
+// buildAnalytics aggregates participants by country and by vote time, in
+// buckets of the given size in loc. An hourly timeline over more than
+// maxHourlySpan falls back to daily buckets, with a warning on warn.
+func buildAnalytics(poll *api.Poll, results *api.PollResults, bucket string, loc *time.Location, warn io.Writer) *analyticsJSON {
+	a := &analyticsJSON{
+		ID:           poll.ID,
+		Title:        poll.Title,
+		Participants: len(results.PollParticipants),
+		CreatedAt:    time.Unix(poll.CreatedAt, 0).In(loc),
+		Countries:    []countryJSON{},
+		Timeline:     []timelineEntry{},
+	}
+
+	votes := make([]time.Time, 0, len(results.PollParticipants))
+	countries := make(map[string]int)
+
+	for _, p := range results.PollParticipants {
+		votes = append(votes, time.Unix(p.CreatedAt, 0).In(loc))
+		countries[strings.ToUpper(p.CountryCode)]++
+	}
+
+	for code, n := range countries {
+		a.Countries = append(a.Countries, countryJSON{
+			Code:         code,
+			Participants: n,
+			Percentage:   percent(n, a.Participants),
+		})
+	}
+
+	// Most participants first; unknown countries last.
+	sort.Slice(a.Countries, func(i, j int) bool {
+		if (a.Countries[i].Code == "") != (a.Countries[j].Code == "") {
+			return a.Countries[j].Code == ""
+		}
+
+		if a.Countries[i].Participants != a.Countries[j].Participants {
+			return a.Countries[i].Participants > a.Countries[j].Participants
+		}
+
+		return a.Countries[i].Code < a.Countries[j].Code
+	})
+
+	if change := lastChange(poll); change > 0 {
+		t := time.Unix(change, 0).In(loc)
+		a.LastChangeAt = &t
+	}
+
+	if len(votes) == 0 {
+		a.Bucket = bucketSize(bucket, 0)
+
+		return a
+	}
+
+	sort.Slice(votes, func(i, j int) bool { return votes[i].Before(votes[j]) })
+
+	first, last := votes[0], votes[len(votes)-1]
+	wait := int64(first.Sub(a.CreatedAt).Seconds())
+	a.FirstVoteAt, a.LastVoteAt, a.TimeToFirstVote = &first, &last, &wait
+
+	for _, v := range votes {
+		if a.LastChangeAt != nil && !v.Before(*a.LastChangeAt) {
+			a.VotesSinceChange++
+		}
+	}
+
+	a.ShareSinceChange = percent(a.VotesSinceChange, len(votes))
+	if a.LastChangeAt == nil {
+		// Never edited or reset: every vote saw the current poll.
+		a.VotesSinceChange, a.ShareSinceChange = len(votes), 100
+	}
+
+	span := last.Sub(first)
+
+	a.Bucket = bucketSize(bucket, span)
+	if a.Bucket == "hour" && span > maxHourlySpan {
+		fmt.Fprintf(warn, "warning: votes span %s; using daily buckets instead of hourly\n", elapsed(span))
+		a.Bucket = "day"
+	}
+
+	step := truncateBucket(first, a.Bucket)
+
+	for i := 0; step.Before(last) || step.Equal(last); step = nextBucket(step, a.Bucket) {
+		entry := timelineEntry{Start: step}
+		end := nextBucket(step, a.Bucket)
+
+		for ; i < len(votes) && votes[i].Before(end); i++ {
+			entry.Votes++
+		}
+
+		a.Timeline = append(a.Timeline, entry)
+	}
+
+	return a
+}
+
+// lastChange returns the later of the poll's update and reset times, as a
+// Unix timestamp, or 0 when it has neither.
+func lastChange(p *api.Poll) int64 {
+	var t int64
+
+	if p.UpdatedAt != nil {
+		t = *p.UpdatedAt
+	}
+
+	if p.ResetAt != nil && *p.ResetAt > t {
+		t = *p.ResetAt
+	}
+
+	return t
+}
+
+// bucketSize resolves "auto" to hourly buckets for spans up to two days.
+func bucketSize(bucket string, span time.Duration) string {
+	if bucket != "auto" && bucket != "" {
+		return bucket
+	}
+
+	if span <= 48*time.Hour {
+		return "hour"
+	}
+
+	return "day"
+}
+
+func truncateBucket(t time.Time, bucket string) time.Time {
+	if bucket == "hour" {
+		return time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), 0, 0, 0, t.Location())
+	}
+
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
+}
+
+func nextBucket(t time.Time, bucket string) time.Time {
+	if bucket == "hour" {
+		return t.Add(time.Hour)
+	}
+
+	return t.AddDate(0, 0, 1)
+}
+
+// analyticsSummary lists the headline numbers and the vote timeline as a
+// sparkline, or its peak alone for accessible output.
+func analyticsSummary(a *analyticsJSON, f *output.Formatter) [][2]string {
+	tf := output.Times()
+	pairs := [][2]string{
+		{"Poll", a.Title},
+		{"Participants", strconv.Itoa(a.Participants)},
+		{"Created", tf.DateTime(a.CreatedAt)},
+	}
+
+	if a.FirstVoteAt == nil {
+		return append(pairs, [2]string{"First vote", "none yet"})
+	}
+
+	pairs = append(pairs,
+		[2]string{"First vote", tf.DateTime(*a.FirstVoteAt)},
+		[2]string{"Time to first vote", elapsed(time.Duration(*a.TimeToFirstVote) * time.Second)},
+		[2]string{"Last vote", tf.DateTime(*a.LastVoteAt)},
+	)
+
+	since := "never edited or reset"
+	if a.LastChangeAt != nil {
+		since = fmt.Sprintf("%d of %d votes (%.1f%%) since %s",
+			a.VotesSinceChange, a.Participants, a.ShareSinceChange, tf.DateTime(*a.LastChangeAt))
+	}
+
+	counts := make([]int, len(a.Timeline))
+	peak := a.Timeline[0]
+
+	for i, e := range a.Timeline {
+		counts[i] = e.Votes
+		if e.Votes > peak.Votes {
+			peak = e
+		}
+	}
+
+	timeline := fmt.Sprintf("peak %d from %s", peak.Votes, tf.DateTime(peak.Start))
+	if !f.Accessible {
+		ascii := f.Mode != output.ModeTable || !f.Colors.Enabled()
+		timeline = output.Sparkline(counts, ascii) + "  " + timeline
+	}
+
+	return append(pairs,
+		[2]string{"Last change", since},
+		[2]string{"Votes per " + a.Bucket, timeline},
+	)
+}
+
+// elapsed formats a non-negative duration like output.Remaining, with "0s"
+// for none.
+func elapsed(d time.Duration) string {
+	if d < time.Second {
+		return "0s"
+	}
+
+	return output.Remaining(d)
+}
+
+func countryTable(a *analyticsJSON) ([]string, [][]string) {
+	headers := []string{"Country", "Participants", "Percentage"}
+	rows := make([][]string, 0, len(a.Countries))
+
+	for _, c := range a.Countries {
+		code := c.Code
+		if code == "" {
+			code = "Unknown"
+		}
+
+		rows = append(rows, []string{code, strconv.Itoa(c.Participants), fmt.Sprintf("%.1f%%", c.Percentage)})
+	}
+
+	return headers, rows
+}
+
+func timelineTable(a *analyticsJSON) ([]string, [][]string) {
+	tf := output.Times()
+	headers := []string{"From", "Votes"}
+	rows := make([][]string, 0, len(a.Timeline))
+
+	for _, e := range a.Timeline {
+		from := tf.Date(e.Start)
+		if a.Bucket == "hour" {
+			from = tf.DateTime(e.Start)
+		}
+
+		rows = append(rows, []string{from, strconv.Itoa(e.Votes)})
+	}
+
+	return headers, rows
+}
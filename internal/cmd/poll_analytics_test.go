@@ -0,0 +1,224 @@
+package cmd
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/dedene/strawpoll-cli/internal/api"
+)
+
+// analyticsLoc is half an hour off UTC, so bucket starts differ from UTC
+// ones when loc is ignored.
+var analyticsLoc = time.FixedZone("UTC+5:30", 5*3600+30*60)
+
+// analyticsCreated is the creation time of analyticsPoll.
+var analyticsCreated = time.Date(2026, 3, 1, 10, 0, 0, 0, analyticsLoc)
+
+// analyticsPoll returns a poll created at analyticsCreated with one
+// participant per vote offset and country code.
+func analyticsPoll(offsets []time.Duration, countries []string) (*api.Poll, *api.PollResults) {
+	poll := &api.Poll{ID: "abc", Title: "Lunch", CreatedAt: analyticsCreated.Unix()}
+	results := &api.PollResults{}
+
+	for i, d := range offsets {
+		p := &api.PollParticipant{CreatedAt: analyticsCreated.Add(d).Unix()}
+		if countries != nil {
+			p.CountryCode = countries[i]
+		}
+
+		results.PollParticipants = append(results.PollParticipants, p)
+	}
+
+	return poll, results
+}
+
+func TestBuildAnalytics_Buckets(t *testing.T) {
+	at := func(day, hour, minute int) time.Time {
+		return time.Date(2026, 3, day, hour, minute, 0, 0, analyticsLoc)
+	}
+
+	tests := []struct {
+		name     string
+		bucket   string
+		offsets  []time.Duration
+		want     string
+		timeline []timelineEntry
+		warned   bool
+	}{
+		{
+			name: "auto hourly", bucket: "auto",
+			offsets: []time.Duration{10 * time.Minute, 70 * time.Minute, 75 * time.Minute, 190 * time.Minute},
+			want:    "hour",
+			timeline: []timelineEntry{
+				{Start: at(1, 10, 0), Votes: 1},
+				{Start: at(1, 11, 0), Votes: 2},
+				{Start: at(1, 12, 0), Votes: 0},
+				{Start: at(1, 13, 0), Votes: 1},
+			},
+		},
+		{
+			name: "auto daily", bucket: "auto",
+			offsets: []time.Duration{time.Hour, 2 * time.Hour, 72 * time.Hour},
+			want:    "day",
+			timeline: []timelineEntry{
+				{Start: at(1, 0, 0), Votes: 2},
+				{Start: at(2, 0, 0), Votes: 0},
+				{Start: at(3, 0, 0), Votes: 0},
+				{Start: at(4, 0, 0), Votes: 1},
+			},
+		},
+		{
+			name: "explicit day", bucket: "day",
+			offsets:  []time.Duration{time.Minute, 2 * time.Hour},
+			want:     "day",
+			timeline: []timelineEntry{{Start: at(1, 0, 0), Votes: 2}},
+		},
+		{
+			name: "explicit hour over days", bucket: "hour",
+			offsets: []time.Duration{0, 72 * time.Hour},
+			want:    "hour",
+		},
+		{
+			name: "explicit hour clamped", bucket: "hour",
+			offsets: []time.Duration{0, 90 * 24 * time.Hour},
+			want:    "day", warned: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			poll, results := analyticsPoll(tt.offsets, nil)
+
+			var warn bytes.Buffer
+
+			a := buildAnalytics(poll, results, tt.bucket, analyticsLoc, &warn)
+
+			if a.Bucket != tt.want {
+				t.Errorf("Bucket = %q, want %q", a.Bucket, tt.want)
+			}
+
+			if tt.warned != (warn.Len() > 0) {
+				t.Errorf("warning = %q, want warned %v", warn.String(), tt.warned)
+			}
+
+			total := 0
+			for _, e := range a.Timeline {
+				total += e.Votes
+			}
+
+			if total != len(tt.offsets) {
+				t.Errorf("timeline counts %d votes, want %d", total, len(tt.offsets))
+			}
+
+			if tt.timeline == nil {
+				return
+			}
+
+			if len(a.Timeline) != len(tt.timeline) {
+				t.Fatalf("Timeline = %v, want %v", a.Timeline, tt.timeline)
+			}
+
+			for i, e := range a.Timeline {
+				if !e.Start.Equal(tt.timeline[i].Start) || e.Votes != tt.timeline[i].Votes {
+					t.Errorf("Timeline[%d] = %v, want %v", i, e, tt.timeline[i])
+				}
+			}
+		})
+	}
+}
+
+func TestBuildAnalytics_Empty(t *testing.T) {
+	poll, results := analyticsPoll(nil, nil)
+
+	a := buildAnalytics(poll, results, "auto", analyticsLoc, &bytes.Buffer{})
+
+	if a.Participants != 0 || a.FirstVoteAt != nil || a.LastVoteAt != nil || a.TimeToFirstVote != nil {
+		t.Errorf("analytics = %+v, want no votes", a)
+	}
+
+	if a.Countries == nil || len(a.Countries) != 0 || a.Timeline == nil || len(a.Timeline) != 0 {
+		t.Errorf("Countries = %v, Timeline = %v, want empty lists", a.Countries, a.Timeline)
+	}
+
+	if a.Bucket != "hour" {
+		t.Errorf("Bucket = %q, want hour", a.Bucket)
+	}
+
+	if !a.CreatedAt.Equal(analyticsCreated) || a.CreatedAt.Location() != analyticsLoc {
+		t.Errorf("CreatedAt = %v, want %v", a.CreatedAt, analyticsCreated)
+	}
+}
+
+func TestBuildAnalytics_LastChange(t *testing.T) {
+	unix := func(d time.Duration) *int64 {
+		u := analyticsCreated.Add(d).Unix()
+		return &u
+	}
+	offsets := []time.Duration{time.Minute, 2 * time.Hour, 3 * time.Hour, 4 * time.Hour}
+
+	tests := []struct {
+		name    string
+		updated *int64
+		reset   *int64
+		change  time.Time
+		since   int
+		share   float64
+	}{
+		{name: "never changed", since: 4, share: 100},
+		{
+			name: "updated", updated: unix(2 * time.Hour),
+			change: analyticsCreated.Add(2 * time.Hour), since: 3, share: 75,
+		},
+		{
+			name: "reset after update", updated: unix(time.Hour), reset: unix(3*time.Hour + time.Minute),
+			change: analyticsCreated.Add(3*time.Hour + time.Minute), since: 1, share: 25,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			poll, results := analyticsPoll(offsets, nil)
+			poll.UpdatedAt, poll.ResetAt = tt.updated, tt.reset
+
+			a := buildAnalytics(poll, results, "auto", analyticsLoc, &bytes.Buffer{})
+
+			switch {
+			case tt.change.IsZero() && a.LastChangeAt != nil:
+				t.Errorf("LastChangeAt = %v, want nil", a.LastChangeAt)
+			case !tt.change.IsZero() && (a.LastChangeAt == nil || !a.LastChangeAt.Equal(tt.change)):
+				t.Errorf("LastChangeAt = %v, want %v", a.LastChangeAt, tt.change)
+			}
+
+			if a.VotesSinceChange != tt.since || a.ShareSinceChange != tt.share {
+				t.Errorf("since change = %d (%v%%), want %d (%v%%)",
+					a.VotesSinceChange, a.ShareSinceChange, tt.since, tt.share)
+			}
+
+			if *a.TimeToFirstVote != 60 {
+				t.Errorf("TimeToFirstVote = %d, want 60", *a.TimeToFirstVote)
+			}
+		})
+	}
+}
+
+func TestBuildAnalytics_Countries(t *testing.T) {
+	offsets := make([]time.Duration, 7)
+	poll, results := analyticsPoll(offsets, []string{"", "nl", "be", "", "BE", "de", ""})
+
+	a := buildAnalytics(poll, results, "auto", analyticsLoc, &bytes.Buffer{})
+
+	// Unknown countries come last even with the most participants; equal
+	// counts sort by code.
+	want := []countryJSON{
+		{Code: "BE", Participants: 2, Percentage: percent(2, 7)},
+		{Code: "DE", Participants: 1, Percentage: percent(1, 7)},
+		{Code: "NL", Participants: 1, Percentage: percent(1, 7)},
+		{Code: "", Participants: 3, Percentage: percent(3, 7)},
+	}
+
+	if !reflect.DeepEqual(a.Countries, want) {
+		t.Errorf("Countries = %+v, want %+v", a.Countries, want)
+	}
+}
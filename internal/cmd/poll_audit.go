@@ -0,0 +1,178 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/dedene/strawpoll-cli/internal/api"
+	"github.com/dedene/strawpoll-cli/internal/audit"
+)
+
+// PollAuditCmd reports suspicious voting patterns in a poll.
+type PollAuditCmd struct {
+	ID          string        `arg:"" required:"" help:"Poll ID or URL"`
+	MinSeverity string        `help:"Only show findings at least this severe: low, medium, high" default:"low" enum:"low,medium,high" name:"min-severity"`
+	BurstWindow time.Duration `help:"Window for vote bursts" default:"10s" name:"burst-window"`
+	BurstSize   int           `help:"Votes within --burst-window that make a burst" default:"3" name:"burst-size"`
+}
+
+// auditJSON is the structured output of poll audit.
+type auditJSON struct {
+	ID           string          `json:"id"`
+	Title        string          `json:"title"`
+	Participants int             `json:"participants"`
+	Dupcheck     string          `json:"duplicationChecking"`
+	Findings     []audit.Finding `json:"findings"`
+}
+
+// Run fetches a poll's participants and prints the audit findings.
+func (c *PollAuditCmd) Run(flags *RootFlags) error {
+	id := api.ParsePollID(c.ID)
+
+	client, err := newClientFromAuth()
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	ctx := context.Background()
+
+	poll, err := client.GetPoll(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	results, err := client.GetPollResults(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if len(results.PollParticipants) == 0 && results.ParticipantCount > 0 {
+		return fmt.Errorf("participants are hidden for poll %s; nothing to audit", poll.ID)
+	}
+
+	report := &auditJSON{
+		ID:           poll.ID,
+		Title:        poll.Title,
+		Participants: len(results.PollParticipants),
+		Dupcheck:     dupcheck(poll),
+		Findings:     []audit.Finding{},
+	}
+
+	findings := audit.Run(auditVotes(poll, results), audit.Options{
+		BurstWindow: c.BurstWindow,
+		BurstSize:   c.BurstSize,
+		Unprotected: report.Dupcheck == api.DupcheckNone,
+	})
+
+	for _, fd := range findings {
+		if fd.Severity.AtLeast(audit.Severity(c.MinSeverity)) {
+			report.Findings = append(report.Findings, fd)
+		}
+	}
+
+	f := newFormatter(flags)
+
+	if f.UsesValue() {
+		return f.Output(report, nil, nil)
+	}
+
+	if err := f.OutputSingle(report, [][2]string{
+		{"Poll", report.Title},
+		{"Participants", strconv.Itoa(report.Participants)},
+		{"Duplicate checking", report.Dupcheck},
+		{"Findings", strconv.Itoa(len(report.Findings))},
+	}); err != nil {
+		return err
+	}
+
+	if len(report.Findings) == 0 {
+		return nil
+	}
+
+	fmt.Fprintln(f.Writer)
+
+	headers, rows := findingsTable(report.Findings)
+
+	return f.Output(report, headers, rows)
+}
+
+// dupcheck returns the poll's duplicate vote checking, defaulting to the
+// API's ip, or none for meeting polls.
+func dupcheck(p *api.Poll) string {
+	if p.PollConfig == nil || p.PollConfig.DuplicationChecking == "" {
+		if p.Type == api.PollTypeMeeting {
+			return api.DupcheckNone
+		}
+
+		return api.DupcheckIP
+	}
+
+	return p.PollConfig.DuplicationChecking
+}
+
+// auditVotes converts participants for the detectors; ballots render the
+// participant's votes so identical choices compare equal.
+func auditVotes(poll *api.Poll, results *api.PollResults) []audit.Vote {
+	votes := make([]audit.Vote, 0, len(results.PollParticipants))
+
+	for _, p := range results.PollParticipants {
+		votes = append(votes, audit.Vote{
+			ID:      p.ID,
+			Name:    p.Name,
+			Country: p.CountryCode,
+			At:      time.Unix(p.CreatedAt, 0),
+			Ballot:  auditBallot(poll, p),
+		})
+	}
+
+	return votes
+}
+
+// auditBallot renders a participant's votes. Ranking and meeting votes
+// hold one value per option position; other poll types list the chosen
+// option indices, which are sorted so the order the API returns them in
+// does not matter.
+func auditBallot(poll *api.Poll, p *api.PollParticipant) string {
+	var choices []string
+
+	switch poll.Type {
+	case api.PollTypeRanking, api.PollTypeMeeting:
+		for _, v := range p.PollVotes {
+			c := "-"
+			if v != nil {
+				c = strconv.Itoa(*v)
+			}
+
+			choices = append(choices, c)
+		}
+
+	default:
+		for _, i := range slices.Sorted(maps.Keys(voteSet(p.PollVotes))) {
+			choices = append(choices, strconv.Itoa(i))
+		}
+	}
+
+	return strings.Join(choices, ",")
+}
+
+func findingsTable(findings []audit.Finding) ([]string, [][]string) {
+	headers := []string{"Severity", "Kind", "Finding", "Evidence"}
+	rows := make([][]string, 0, len(findings))
+
+	for _, fd := range findings {
+		rows = append(rows, []string{
+			string(fd.Severity),
+			string(fd.Kind),
+			fd.Summary,
+			strings.Join(fd.Evidence, "\n"),
+		})
+	}
+
+	return headers, rows
+}
@@ -0,0 +1,71 @@
+package cmd
+
+import (
+	"testing"
+
+	"github.com/dedene/strawpoll-cli/internal/api"
+)
+
+func TestAuditVotes_Ballots(t *testing.T) {
+	tests := []struct {
+		name     string
+		pollType string
+		a, b     []*int
+		same     bool
+	}{
+		// The API may list the same chosen options in any order.
+		{name: "multiple choice reordered", pollType: api.PollTypeMultipleChoice, a: diffVotes(2, 0), b: diffVotes(0, 2), same: true},
+		{name: "multiple choice differs", pollType: api.PollTypeMultipleChoice, a: diffVotes(0, 1), b: diffVotes(0, 2)},
+		// Ranking votes are positional, so swapped values are a different ballot.
+		{name: "ranking swapped", pollType: api.PollTypeRanking, a: diffVotes(1, 0), b: diffVotes(0, 1)},
+		{name: "ranking same", pollType: api.PollTypeRanking, a: diffVotes(1, -1, 0), b: diffVotes(1, -1, 0), same: true},
+		{name: "meeting swapped", pollType: api.PollTypeMeeting, a: diffVotes(1, 0), b: diffVotes(0, 1)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			poll := &api.Poll{ID: "abc", Type: tt.pollType}
+			results := &api.PollResults{
+				PollParticipants: []*api.PollParticipant{
+					{ID: "p1", PollVotes: tt.a},
+					{ID: "p2", PollVotes: tt.b},
+				},
+			}
+
+			votes := auditVotes(poll, results)
+
+			if got := votes[0].Ballot == votes[1].Ballot; got != tt.same {
+				t.Errorf("ballots %q and %q equal = %v, want %v", votes[0].Ballot, votes[1].Ballot, got, tt.same)
+			}
+		})
+	}
+}
+
+func TestDupcheck(t *testing.T) {
+	tests := []struct {
+		name string
+		poll *api.Poll
+		want string
+	}{
+		{name: "unset", poll: &api.Poll{Type: api.PollTypeMultipleChoice}, want: api.DupcheckIP},
+		{name: "unset meeting", poll: &api.Poll{Type: api.PollTypeMeeting}, want: api.DupcheckNone},
+		{
+			name: "set meeting",
+			poll: &api.Poll{Type: api.PollTypeMeeting, PollConfig: &api.PollConfig{DuplicationChecking: api.DupcheckSession}},
+			want: api.DupcheckSession,
+		},
+		{
+			name: "set",
+			poll: &api.Poll{Type: api.PollTypeRanking, PollConfig: &api.PollConfig{DuplicationChecking: api.DupcheckNone}},
+			want: api.DupcheckNone,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := dupcheck(tt.poll); got != tt.want {
+				t.Errorf("dupcheck = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -10,9 +10,7 @@ import (
 	"github.com/pkg/browser"
 
 	"github.com/dedene/strawpoll-cli/internal/api"
-	"github.com/dedene/strawpoll-cli/internal/auth"
 	"github.com/dedene/strawpoll-cli/internal/config"
-	"github.com/dedene/strawpoll-cli/internal/output"
 	"github.com/dedene/strawpoll-cli/internal/tui"
 )
 
@@ -77,22 +75,19 @@ func (c *PollCreateCmd) Run(flags *RootFlags) error {
 
 // createFromFlags creates a poll using the values already set on the command struct.
 func (c *PollCreateCmd) createFromFlags(flags *RootFlags) error {
-	if len(c.Options) < 2 || len(c.Options) > 30 {
-		return fmt.Errorf("poll requires 2-30 options, got %d", len(c.Options))
-	}
-
 	// Apply config defaults
 	cfg, _ := config.ReadConfig()
 	c.applyDefaults(cfg)
 
-	apiKey, err := auth.GetAPIKey()
-	if err != nil {
-		return fmt.Errorf("authentication required: %w", err)
-	}
-
 	req := c.buildRequest()
+	if err := api.ValidateCreatePollRequest(req, time.Now()); err != nil {
+		return &ExitError{Code: CodeUsage, Err: err}
+	}
 
-	client := api.NewClient(apiKey)
+	client, err := newWriteClient(flags)
+	if err != nil {
+		return err
+	}
 	defer client.Close()
 
 	poll, err := client.CreatePoll(context.Background(), req)
@@ -102,7 +97,7 @@ func (c *PollCreateCmd) createFromFlags(flags *RootFlags) error {
 
 	pollURL := pollBaseURL + poll.ID
 
-	f := output.NewFormatter(os.Stdout, flags.JSON, flags.Plain, flags.NoColor)
+	f := newFormatter(flags)
 	if err := f.OutputSingle(poll, [][2]string{
 		{"ID", poll.ID},
 		{"Title", poll.Title},
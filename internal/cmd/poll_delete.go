@@ -6,7 +6,6 @@ import (
 	"os"
 
 	"github.com/dedene/strawpoll-cli/internal/api"
-	"github.com/dedene/strawpoll-cli/internal/auth"
 	"github.com/dedene/strawpoll-cli/internal/tui"
 )
 
@@ -17,10 +16,10 @@ type PollDeleteCmd struct {
 }
 
 // Run deletes a poll, prompting for confirmation unless --force.
-func (c *PollDeleteCmd) Run(_ *RootFlags) error {
+func (c *PollDeleteCmd) Run(flags *RootFlags) error {
 	id := api.ParsePollID(c.ID)
 
-	if !c.Force {
+	if !c.Force && !flags.DryRun {
 		confirmed, err := tui.Confirm(fmt.Sprintf("Delete poll %s? This cannot be undone.", id))
 		if err != nil {
 			return err
@@ -33,12 +32,10 @@ func (c *PollDeleteCmd) Run(_ *RootFlags) error {
 		}
 	}
 
-	apiKey, err := auth.GetAPIKey()
+	client, err := newWriteClient(flags)
 	if err != nil {
-		return fmt.Errorf("authentication required: %w", err)
+		return err
 	}
-
-	client := api.NewClient(apiKey)
 	defer client.Close()
 
 	if err := client.DeletePoll(context.Background(), id); err != nil {
@@ -0,0 +1,289 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"strconv"
+	"time"
+
+	"github.com/dedene/strawpoll-cli/internal/api"
+	"github.com/dedene/strawpoll-cli/internal/output"
+	"github.com/dedene/strawpoll-cli/internal/snapshot"
+)
+
+// PollDiffCmd compares a poll's current results with a saved snapshot.
+type PollDiffCmd struct {
+	ID    string `arg:"" required:"" help:"Poll ID or URL"`
+	Since string `help:"Snapshot to compare with: a name from poll snapshot --list, or an age such as 24h or 2d for the latest snapshot at least that old (default: the latest snapshot)"`
+}
+
+// diffJSON is the structured output of poll diff.
+type diffJSON struct {
+	ID           string            `json:"id"`
+	Snapshot     string            `json:"snapshot"`
+	Since        time.Time         `json:"since"`
+	Unit         string            `json:"unit"`
+	Participants countDiffJSON     `json:"participants"`
+	Votes        countDiffJSON     `json:"votes"`
+	Options      []optionDiffJSON  `json:"options"`
+	Changes      []participantDiff `json:"changes"`
+}
+
+// countDiffJSON is a total at the snapshot and now.
+type countDiffJSON struct {
+	Before int `json:"before"`
+	After  int `json:"after"`
+}
+
+type optionDiffJSON struct {
+	ID     string `json:"id"`
+	Value  string `json:"value"`
+	Before int    `json:"before"`
+	After  int    `json:"after"`
+	Delta  int    `json:"delta"`
+}
+
+// participantDiff is a participant who voted, changed their ballot (when
+// the poll allows editing votes) or was removed since the snapshot.
+type participantDiff struct {
+	ID     string `json:"id"`
+	Name   string `json:"name"`
+	Change string `json:"change"`
+	Before string `json:"before,omitempty"`
+	After  string `json:"after,omitempty"`
+}
+
+// Run loads the snapshot, fetches the current results and prints what
+// changed in between.
+func (c *PollDiffCmd) Run(flags *RootFlags) error {
+	id := api.ParsePollID(c.ID)
+
+	store, err := snapshotStore()
+	if err != nil {
+		return err
+	}
+
+	entry, err := store.Find(id, c.Since, time.Now())
+	if errors.Is(err, snapshot.ErrNotFound) {
+		return &ExitError{Code: CodeUsage, Err: fmt.Errorf("%w; take one with: strawpoll poll snapshot %s", err, id)}
+	}
+
+	if err != nil {
+		return &ExitError{Code: CodeUsage, Err: err}
+	}
+
+	snap, err := store.Load(entry)
+	if err != nil {
+		return err
+	}
+
+	if snap.Results == nil {
+		return fmt.Errorf("snapshot %s has no results", entry.Name)
+	}
+
+	client, err := newClientFromAuth()
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	ctx := context.Background()
+
+	poll, err := client.GetPoll(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	results, err := client.GetPollResults(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	d := diffResults(poll, snap, results)
+	f := newFormatter(flags)
+
+	if f.UsesValue() {
+		return f.Output(d, nil, nil)
+	}
+
+	if err := f.OutputSingle(d, diffSummary(d)); err != nil {
+		return err
+	}
+
+	fmt.Fprintln(f.Writer)
+
+	headers, rows := optionDiffTable(d)
+	if err := f.Output(d, headers, rows); err != nil {
+		return err
+	}
+
+	if len(d.Changes) == 0 {
+		return nil
+	}
+
+	fmt.Fprintln(f.Writer)
+
+	headers, rows = participantDiffTable(d)
+
+	return f.Output(d, headers, rows)
+}
+
+// diffResults compares the snapshot with the current results. Options and
+// participants are matched by ID, and option values use the same measure
+// as poll watch: votes, Borda points or "yes" availability.
+func diffResults(poll *api.Poll, snap *snapshot.Snapshot, cur *api.PollResults) *diffJSON {
+	old := snap.Results
+	now := time.Now()
+	before := watchSnapshot(poll, old, now)
+	after := watchSnapshot(poll, cur, now)
+
+	d := &diffJSON{
+		ID:           poll.ID,
+		Snapshot:     snap.Name(),
+		Since:        snap.TakenAt,
+		Unit:         after.Unit,
+		Participants: countDiffJSON{old.ParticipantCount, cur.ParticipantCount},
+		Votes:        countDiffJSON{old.VoteCount, cur.VoteCount},
+		Options:      []optionDiffJSON{},
+		Changes:      []participantDiff{},
+	}
+
+	oldValues := make(map[string]int, len(old.PollOptions))
+	for i, opt := range old.PollOptions {
+		oldValues[opt.ID] = before.Rows[i].Value
+	}
+
+	for i, opt := range cur.PollOptions {
+		v := after.Rows[i].Value
+		d.Options = append(d.Options, optionDiffJSON{
+			ID:     opt.ID,
+			Value:  after.Rows[i].Label,
+			Before: oldValues[opt.ID],
+			After:  v,
+			Delta:  v - oldValues[opt.ID],
+		})
+	}
+
+	oldVoters := make(map[string]*api.PollParticipant, len(old.PollParticipants))
+	for _, p := range old.PollParticipants {
+		oldVoters[p.ID] = p
+	}
+
+	var added, changed, removed []participantDiff
+
+	seen := make(map[string]bool, len(cur.PollParticipants))
+
+	for _, p := range cur.PollParticipants {
+		seen[p.ID] = true
+
+		prev, ok := oldVoters[p.ID]
+		switch {
+		case !ok:
+			added = append(added, participantDiff{
+				ID: p.ID, Name: participantName(p), Change: "new", After: ballotSummary(poll, cur, p),
+			})
+		case !maps.Equal(ballotByOption(poll, old, prev), ballotByOption(poll, cur, p)):
+			changed = append(changed, participantDiff{
+				ID: p.ID, Name: participantName(p), Change: "changed",
+				Before: ballotSummary(poll, old, prev), After: ballotSummary(poll, cur, p),
+			})
+		}
+	}
+
+	for _, p := range old.PollParticipants {
+		if !seen[p.ID] {
+			removed = append(removed, participantDiff{
+				ID: p.ID, Name: participantName(p), Change: "removed", Before: ballotSummary(poll, old, p),
+			})
+		}
+	}
+
+	d.Changes = append(append(append(d.Changes, added...), changed...), removed...)
+
+	return d
+}
+
+// ballotByOption keys a participant's votes by option ID, so ballots
+// compare equal across results whose options were added, removed or
+// reordered in between. Ranking and meeting votes hold one value per
+// option position; other poll types list the indices of the chosen
+// options.
+func ballotByOption(poll *api.Poll, results *api.PollResults, p *api.PollParticipant) map[string]int {
+	opts := results.PollOptions
+	ballot := make(map[string]int, len(p.PollVotes))
+
+	switch poll.Type {
+	case api.PollTypeRanking, api.PollTypeMeeting:
+		for i, v := range p.PollVotes {
+			if v != nil && i < len(opts) {
+				ballot[opts[i].ID] = *v
+			}
+		}
+
+	default:
+		for i := range voteSet(p.PollVotes) {
+			if i >= 0 && i < len(opts) {
+				ballot[opts[i].ID] = 1
+			}
+		}
+	}
+
+	return ballot
+}
+
+// signed formats a change with an explicit sign.
+func signed(n int) string {
+	if n > 0 {
+		return "+" + strconv.Itoa(n)
+	}
+
+	return strconv.Itoa(n)
+}
+
+// diffSummary describes the compared snapshot and the change in totals.
+func diffSummary(d *diffJSON) [][2]string {
+	tf := output.Times()
+	total := func(c countDiffJSON) string {
+		return fmt.Sprintf("%d (%s)", c.After, signed(c.After-c.Before))
+	}
+
+	counts := make(map[string]int)
+	for _, ch := range d.Changes {
+		counts[ch.Change]++
+	}
+
+	return [][2]string{
+		{"Poll", d.ID},
+		{"Since", fmt.Sprintf("%s (snapshot %s)", tf.DateTime(d.Since.Local()), d.Snapshot)},
+		{"Participants", total(d.Participants)},
+		{"Votes", total(d.Votes)},
+		{"Options in", d.Unit},
+		{"Ballots", fmt.Sprintf("%d new, %d changed, %d removed", counts["new"], counts["changed"], counts["removed"])},
+	}
+}
+
+// optionDiffTable lists each option's value before and after, in poll order.
+func optionDiffTable(d *diffJSON) ([]string, [][]string) {
+	headers := []string{"Option", "Before", "After", "Change"}
+	rows := make([][]string, 0, len(d.Options))
+
+	for _, o := range d.Options {
+		rows = append(rows, []string{o.Value, strconv.Itoa(o.Before), strconv.Itoa(o.After), signed(o.Delta)})
+	}
+
+	return headers, rows
+}
+
+// participantDiffTable lists new, changed and removed ballots.
+func participantDiffTable(d *diffJSON) ([]string, [][]string) {
+	headers := []string{"Change", "Name", "Before", "After"}
+	rows := make([][]string, 0, len(d.Changes))
+
+	for _, ch := range d.Changes {
+		rows = append(rows, []string{ch.Change, ch.Name, ch.Before, ch.After})
+	}
+
+	return headers, rows
+}
@@ -0,0 +1,105 @@
+package cmd
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/dedene/strawpoll-cli/internal/api"
+	"github.com/dedene/strawpoll-cli/internal/snapshot"
+)
+
+// diffVotes builds PollVotes, with -1 standing for a missing vote.
+func diffVotes(values ...int) []*int {
+	votes := make([]*int, len(values))
+	for i, v := range values {
+		if v >= 0 {
+			votes[i] = &v
+		}
+	}
+
+	return votes
+}
+
+func TestDiffResults_MultipleChoice(t *testing.T) {
+	poll := &api.Poll{ID: "abc", Type: api.PollTypeMultipleChoice}
+	old := &api.PollResults{
+		ParticipantCount: 3,
+		VoteCount:        3,
+		PollOptions: []*api.PollOption{
+			{ID: "a", Value: "Pizza", VoteCount: 2},
+			{ID: "b", Value: "Sushi", VoteCount: 1},
+		},
+		PollParticipants: []*api.PollParticipant{
+			{ID: "p1", Name: "Alice", PollVotes: diffVotes(1)},
+			{ID: "p2", Name: "Bob", PollVotes: diffVotes(0)},
+			{ID: "p3", Name: "Carol", PollVotes: diffVotes(0)},
+		},
+	}
+	// Tacos was added between Pizza and Sushi, shifting Sushi's index.
+	cur := &api.PollResults{
+		ParticipantCount: 3,
+		VoteCount:        3,
+		PollOptions: []*api.PollOption{
+			{ID: "a", Value: "Pizza", VoteCount: 0},
+			{ID: "n", Value: "Tacos", VoteCount: 1},
+			{ID: "b", Value: "Sushi", VoteCount: 2},
+		},
+		PollParticipants: []*api.PollParticipant{
+			{ID: "p1", Name: "Alice", PollVotes: diffVotes(2)},
+			{ID: "p2", Name: "Bob", PollVotes: diffVotes(2)},
+			{ID: "p4", Name: "", PollVotes: diffVotes(1)},
+		},
+	}
+	snap := &snapshot.Snapshot{PollID: "abc", TakenAt: time.Date(2026, 10, 16, 9, 0, 0, 0, time.UTC), Results: old}
+
+	d := diffResults(poll, snap, cur)
+
+	wantOptions := []optionDiffJSON{
+		{ID: "a", Value: "Pizza", Before: 2, After: 0, Delta: -2},
+		{ID: "n", Value: "Tacos", Before: 0, After: 1, Delta: 1},
+		{ID: "b", Value: "Sushi", Before: 1, After: 2, Delta: 1},
+	}
+	if !reflect.DeepEqual(d.Options, wantOptions) {
+		t.Errorf("Options = %+v, want %+v", d.Options, wantOptions)
+	}
+
+	wantChanges := []participantDiff{
+		{ID: "p4", Name: "Anonymous", Change: "new", After: "Tacos"},
+		{ID: "p2", Name: "Bob", Change: "changed", Before: "Pizza", After: "Sushi"},
+		{ID: "p3", Name: "Carol", Change: "removed", Before: "Pizza"},
+	}
+	if !reflect.DeepEqual(d.Changes, wantChanges) {
+		t.Errorf("Changes = %+v, want %+v", d.Changes, wantChanges)
+	}
+
+	if d.Snapshot != "20261016T090000Z" || d.Unit != "votes" {
+		t.Errorf("Snapshot = %q, Unit = %q", d.Snapshot, d.Unit)
+	}
+}
+
+func TestDiffResults_Ranking(t *testing.T) {
+	poll := &api.Poll{ID: "abc", Type: api.PollTypeRanking}
+	old := &api.PollResults{
+		PollOptions: []*api.PollOption{{ID: "a", Value: "Pizza"}, {ID: "b", Value: "Sushi"}},
+		PollParticipants: []*api.PollParticipant{
+			{ID: "p1", Name: "Alice", PollVotes: diffVotes(1, 0)},
+			{ID: "p2", Name: "Bob", PollVotes: diffVotes(0, 1)},
+		},
+	}
+	// Tacos was added in between; Alice's ranking is unchanged and Bob
+	// swapped Pizza and Sushi.
+	cur := &api.PollResults{
+		PollOptions: []*api.PollOption{{ID: "a", Value: "Pizza"}, {ID: "n", Value: "Tacos"}, {ID: "b", Value: "Sushi"}},
+		PollParticipants: []*api.PollParticipant{
+			{ID: "p1", Name: "Alice", PollVotes: diffVotes(1, -1, 0)},
+			{ID: "p2", Name: "Bob", PollVotes: diffVotes(1, -1, 0)},
+		},
+	}
+
+	d := diffResults(poll, &snapshot.Snapshot{Results: old}, cur)
+
+	if len(d.Changes) != 1 || d.Changes[0].ID != "p2" || d.Changes[0].Change != "changed" {
+		t.Errorf("Changes = %+v, want Bob changed", d.Changes)
+	}
+}
@@ -0,0 +1,183 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/dedene/strawpoll-cli/internal/api"
+	"github.com/dedene/strawpoll-cli/internal/output"
+)
+
+// PollExportCmd writes poll results to a CSV, Markdown, HTML or XLSX file.
+type PollExportCmd struct {
+	ID     string `arg:"" required:"" help:"Poll ID or URL"`
+	Format string `help:"Export format: csv, md, html or xlsx (inferred from the output file extension if omitted)"`
+	File   string `help:"Output file (default: stdout)" type:"path"`
+}
+
+// Run fetches a poll and its results and writes the export.
+func (c *PollExportCmd) Run(flags *RootFlags) error {
+	format, err := output.ParseExportFormat(c.Format, c.File)
+	if err != nil {
+		return &ExitError{Code: CodeUsage, Err: err}
+	}
+
+	id := api.ParsePollID(c.ID)
+
+	client, err := newClientFromAuth()
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	ctx := context.Background()
+
+	poll, err := client.GetPoll(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	results, err := client.GetPollResults(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	report := exportReport(poll, results, time.Now())
+
+	if c.File == "" {
+		return output.WriteReport(os.Stdout, report, format)
+	}
+
+	file, err := os.Create(c.File)
+	if err != nil {
+		return fmt.Errorf("create export file: %w", err)
+	}
+
+	if err := output.WriteReport(file, report, format); err != nil {
+		file.Close()
+
+		return fmt.Errorf("write export: %w", err)
+	}
+
+	if err := file.Close(); err != nil {
+		return fmt.Errorf("write export: %w", err)
+	}
+
+	fmt.Fprintf(os.Stderr, "Exported %s to %s\n", poll.ID, c.File)
+
+	return nil
+}
+
+// exportReport assembles the metadata, totals, participant matrix and chart
+// for a poll of any type.
+func exportReport(poll *api.Poll, results *api.PollResults, now time.Time) *output.Report {
+	r := &output.Report{
+		Title: poll.Title,
+		Meta: [][2]string{
+			{"ID", poll.ID},
+			{"Type", friendlyType(poll.Type)},
+			{"URL", pollBaseURL + poll.ID},
+			{"Created", time.Unix(poll.CreatedAt, 0).UTC().Format(time.RFC3339)},
+		},
+		GeneratedAt: now,
+	}
+
+	if poll.PollConfig != nil && poll.PollConfig.DeadlineAt != nil {
+		deadline := time.Unix(*poll.PollConfig.DeadlineAt, 0).UTC().Format(time.RFC3339)
+		r.Meta = append(r.Meta, [2]string{"Deadline", deadline})
+	}
+
+	r.Meta = append(r.Meta,
+		[2]string{"Participants", strconv.Itoa(results.ParticipantCount)},
+		[2]string{"Votes", strconv.Itoa(results.VoteCount)},
+	)
+
+	var totals, matrix output.ReportTable
+
+	switch poll.Type {
+	case api.PollTypeRanking:
+		r.ChartTitle = "Borda scores"
+		r.Chart = rankingBars(results)
+		totals.Headers, totals.Rows, _ = rankingScoreTable(results)
+		matrix.Headers, matrix.Rows = rankingParticipantsTable(results)
+
+	case api.PollTypeMeeting:
+		loc := meetingLocation(poll)
+		r.Meta = append(r.Meta, [2]string{"Timezone", meetingTimezoneStr(poll)})
+		r.ChartTitle = "Availability (yes)"
+		r.Chart = meetingBars(poll, results, loc)
+		totals.Headers, totals.Rows = meetingTotalsTable(poll, results, loc)
+		matrix.Headers, matrix.Rows, _ = availabilityGrid(poll, results, loc)
+
+	default:
+		r.ChartTitle = "Votes"
+		r.Chart = resultsBars(results, results.VoteCount)
+		totals.Headers, totals.Rows = resultsTable(results, results.VoteCount)
+		matrix.Headers, matrix.Rows = participantsTable(results, nil)
+	}
+
+	totals.Title = "Results"
+	r.Tables = append(r.Tables, totals)
+
+	if len(results.PollParticipants) > 0 {
+		matrix.Title = "Participants"
+		r.Tables = append(r.Tables, matrix)
+	}
+
+	return r
+}
+
+// meetingTotalsTable counts yes, maybe and no answers per timeslot, in poll order.
+func meetingTotalsTable(poll *api.Poll, results *api.PollResults, loc *time.Location) ([]string, [][]string) {
+	headers := []string{"Slot", "Yes", "Maybe", "No", "Available"}
+	rows := make([][]string, 0, len(poll.PollOptions))
+
+	for i, opt := range poll.PollOptions {
+		var yes, maybe, no int
+
+		for _, p := range results.PollParticipants {
+			if i >= len(p.PollVotes) || p.PollVotes[i] == nil {
+				continue
+			}
+
+			switch *p.PollVotes[i] {
+			case 1:
+				yes++
+			case 2:
+				maybe++
+			case 0:
+				no++
+			}
+		}
+
+		rows = append(rows, []string{
+			formatTimeslot(opt, loc),
+			strconv.Itoa(yes),
+			strconv.Itoa(maybe),
+			strconv.Itoa(no),
+			availabilitySummary(results.PollParticipants, i),
+		})
+	}
+
+	return headers, rows
+}
+
+// meetingBars charts the number of "yes" answers per timeslot.
+func meetingBars(poll *api.Poll, results *api.PollResults, loc *time.Location) []output.Bar {
+	total := len(results.PollParticipants)
+	bars := make([]output.Bar, 0, len(poll.PollOptions))
+
+	for i, opt := range poll.PollOptions {
+		yes := availabilityScore(results.PollParticipants, i) / 1000
+		bars = append(bars, output.Bar{
+			Label:   formatTimeslot(opt, loc),
+			Value:   float64(yes),
+			Display: fmt.Sprintf("%d/%d", yes, total),
+		})
+	}
+
+	return bars
+}
@@ -3,11 +3,9 @@ package cmd
 import (
 	"context"
 	"fmt"
-	"os"
 
 	"github.com/dedene/strawpoll-cli/internal/api"
 	"github.com/dedene/strawpoll-cli/internal/auth"
-	"github.com/dedene/strawpoll-cli/internal/output"
 )
 
 // PollGetCmd retrieves poll details.
@@ -24,7 +22,7 @@ func (c *PollGetCmd) Run(flags *RootFlags) error {
 		return fmt.Errorf("authentication required: %w", err)
 	}
 
-	client := api.NewClient(apiKey)
+	client := newClient(apiKey)
 	defer client.Close()
 
 	poll, err := client.GetPoll(context.Background(), id)
@@ -34,15 +32,15 @@ func (c *PollGetCmd) Run(flags *RootFlags) error {
 
 	pollURL := pollBaseURL + poll.ID
 
-	f := output.NewFormatter(os.Stdout, flags.JSON, flags.Plain, flags.NoColor)
-	return f.OutputSingle(poll, [][2]string{
+	f := newFormatter(flags)
+	return f.OutputSingle(poll, append([][2]string{
 		{"ID", poll.ID},
 		{"Title", poll.Title},
 		{"Type", poll.Type},
 		{"URL", pollURL},
 		{"Options", fmt.Sprintf("%d", len(poll.PollOptions))},
 		{"Votes", voteCount(poll)},
-	})
+	}, pollTimes(poll)...))
 }
 
 func voteCount(p *api.Poll) string {
@@ -5,9 +5,6 @@ import (
 	"fmt"
 	"math"
 	"os"
-	"time"
-
-	"github.com/dedene/strawpoll-cli/internal/output"
 )
 
 // PollListCmd lists the user's polls.
@@ -30,8 +27,8 @@ func (c *PollListCmd) Run(flags *RootFlags) error {
 		return err
 	}
 
-	f := output.NewFormatter(os.Stdout, flags.JSON, flags.Plain, flags.NoColor)
-	headers := []string{"ID", "Title", "Type", "Votes", "Created"}
+	f := newFormatter(flags)
+	headers := []string{"ID", "Title", "Type", "Votes", "Created", "Closes"}
 	rows := make([][]string, 0, len(resp.Data))
 
 	for _, p := range resp.Data {
@@ -45,7 +42,8 @@ func (c *PollListCmd) Run(flags *RootFlags) error {
 			p.Title,
 			friendlyType(p.Type),
 			votes,
-			time.Unix(p.CreatedAt, 0).Format("2006-01-02"),
+			createdCell(p.CreatedAt),
+			closesCell(&p),
 		})
 	}
 
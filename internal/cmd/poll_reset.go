@@ -16,10 +16,10 @@ type PollResetCmd struct {
 }
 
 // Run resets poll results, prompting for confirmation unless --force.
-func (c *PollResetCmd) Run(_ *RootFlags) error {
+func (c *PollResetCmd) Run(flags *RootFlags) error {
 	id := api.ParsePollID(c.ID)
 
-	if !c.Force {
+	if !c.Force && !flags.DryRun {
 		confirmed, err := tui.Confirm(fmt.Sprintf("Reset results for poll %s? This cannot be undone.", id))
 		if err != nil {
 			return err
@@ -31,7 +31,7 @@ func (c *PollResetCmd) Run(_ *RootFlags) error {
 		}
 	}
 
-	client, err := newClientFromAuth()
+	client, err := newWriteClient(flags)
 	if err != nil {
 		return err
 	}
@@ -4,6 +4,8 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"slices"
+	"strconv"
 	"strings"
 
 	"github.com/dedene/strawpoll-cli/internal/api"
@@ -13,39 +15,246 @@ import (
 
 // PollResultsCmd displays poll results.
 type PollResultsCmd struct {
-	ID           string `arg:"" required:"" help:"Poll ID or URL"`
-	Participants bool   `help:"Show per-participant breakdown" short:"p"`
+	IDs          []string `arg:"" name:"id" required:"" help:"Poll IDs or URLs; several polls are fetched concurrently"`
+	Participants bool     `help:"Show per-participant breakdown" short:"p"`
+	Chart        bool     `help:"Show results as a bar chart"`
+	Denominator  string   `help:"Base for percentages: votes, or participants (the share of voters choosing each option in multi-select polls)" default:"votes" enum:"votes,participants"`
+	Stats        bool     `help:"Show confidence intervals and whether the leader's margin is significant"`
+	Alpha        float64  `help:"Significance level for --stats" default:"0.05"`
+	Aggregate    bool     `help:"Combine two or more polls of the same type into one table, merging options with the same value, with a column per poll"`
+
+	WinnerFlags `embed:""`
+	WeightFlags `embed:""`
+}
+
+// pollResultsJSON is the structured output of poll results: the API
+// results with the winners, and statistics when requested. Aggregated
+// results list each poll's votes in Polls, and --weights adds the
+// weighted counts.
+type pollResultsJSON struct {
+	*api.PollResults
+	*winnersJSON
+	Stats   *statsJSON          `json:"stats,omitempty"`
+	Polls   []pollBreakdownJSON `json:"polls,omitempty"`
+	Weights *weightsJSON        `json:"weights,omitempty"`
+
+	// weights holds each participant's weight, in results order.
+	weights []float64
+}
+
+// denominator returns the base for percentages selected by --denominator.
+func (c *PollResultsCmd) denominator(r *api.PollResults) int {
+	if c.Denominator == "participants" {
+		return r.ParticipantCount
+	}
+
+	return r.VoteCount
 }
 
-// Run fetches and displays poll results.
+// Run fetches and displays the results of one or more polls with the
+// winners, either one after another or combined with --aggregate.
 func (c *PollResultsCmd) Run(flags *RootFlags) error {
-	id := api.ParsePollID(c.ID)
+	if c.Stats && (c.Alpha <= 0 || c.Alpha >= 1) {
+		return &ExitError{Code: CodeUsage, Err: fmt.Errorf("--alpha must be between 0 and 1, got %g", c.Alpha)}
+	}
+
+	if c.Aggregate && len(c.IDs) < 2 {
+		return &ExitError{Code: CodeUsage, Err: fmt.Errorf("--aggregate needs at least two poll IDs")}
+	}
+
+	f := newFormatter(flags)
+
+	// Participant grids differ per poll and cannot join the combined table.
+	if c.Participants && len(c.IDs) > 1 && !c.Aggregate && f.Mode != output.ModeTable && !f.UsesValue() {
+		return &ExitError{Code: CodeUsage, Err: fmt.Errorf("--participants with several polls needs table output or --json")}
+	}
+
+	table, err := c.loadWeights()
+	if err != nil {
+		return err
+	}
+
+	ids := make([]string, len(c.IDs))
+	for i, id := range c.IDs {
+		ids[i] = api.ParsePollID(id)
+	}
 
 	apiKey, err := auth.GetAPIKey()
 	if err != nil {
 		return fmt.Errorf("authentication required: %w", err)
 	}
 
-	client := api.NewClient(apiKey)
+	client := newClient(apiKey)
 	defer client.Close()
 
-	results, err := client.GetPollResults(context.Background(), id)
+	// number_of_winners and the poll type live on the poll, not the
+	// results.
+	polls, err := fetchPolls(context.Background(), client, ids, c.Winners == 0 || c.Aggregate)
 	if err != nil {
 		return err
 	}
 
-	f := output.NewFormatter(os.Stdout, flags.JSON, flags.Plain, flags.NoColor)
+	if c.Aggregate {
+		if err := checkPollTypes(polls); err != nil {
+			return err
+		}
 
-	headers, rows := resultsTable(results)
-	if err := f.Output(results, headers, rows); err != nil {
-		return err
+		merged, breakdown := aggregateResults(polls)
+
+		// Aggregated polls use the first poll's number of winners.
+		value, err := c.resultsValue(merged, polls[0].poll, table)
+		if err != nil {
+			return err
+		}
+
+		value.Polls = breakdown
+
+		if err := c.render(f, value); err != nil {
+			return err
+		}
+
+		return c.check(value.winnersJSON)
+	}
+
+	values := make([]*pollResultsJSON, len(polls))
+	for i, p := range polls {
+		if values[i], err = c.resultsValue(p.results, p.poll, table); err != nil {
+			return err
+		}
+	}
+
+	switch {
+	case len(values) > 1 && f.UsesValue():
+		if err := f.Output(values, nil, nil); err != nil {
+			return err
+		}
+	case len(values) > 1 && f.Mode != output.ModeTable:
+		// Headings between polls would break CSV and other data modes.
+		headers, rows := c.combinedTable(f, values)
+		if err := f.Output(values, headers, rows); err != nil {
+			return err
+		}
+	default:
+		for i, value := range values {
+			if len(values) > 1 {
+				if i > 0 {
+					fmt.Fprintln(f.Writer)
+				}
+
+				fmt.Fprintf(f.Writer, "Poll %s\n", value.ID)
+			}
+
+			if err := c.render(f, value); err != nil {
+				return err
+			}
+		}
+	}
+
+	for _, value := range values {
+		if err := c.check(value.winnersJSON); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// resultsValue decides the winners of results and adds statistics when
+// requested. With a weight table the winners follow the weighted counts;
+// statistics stay on the raw counts.
+func (c *PollResultsCmd) resultsValue(results *api.PollResults, poll *api.Poll, table *weightTable) (*pollResultsJSON, error) {
+	counts := make([]int, len(results.PollOptions))
+	for i, opt := range results.PollOptions {
+		counts[i] = opt.VoteCount
+	}
+
+	value := &pollResultsJSON{PollResults: results}
+	scores := intScores(counts)
+
+	if table != nil {
+		weights, report, err := table.apply(results, os.Stderr)
+		if err != nil {
+			return nil, err
+		}
+
+		scores = weightedCounts(results, weights)
+
+		total := report.TotalWeight
+		if c.Denominator != "participants" {
+			total = 0
+			for _, s := range scores {
+				total += s
+			}
+		}
+
+		for i, opt := range results.PollOptions {
+			pct := 0.0
+			if total > 0 {
+				pct = scores[i] / total * 100
+			}
+
+			report.Options = append(report.Options, weightedOptionJSON{
+				Value: opt.Value, Raw: float64(counts[i]), Weighted: scores[i], Percentage: pct,
+			})
+		}
+
+		value.Weights, value.weights = report, weights
+	}
+
+	winners, err := c.decide(poll, results.PollOptions, scores, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	value.winnersJSON = winners
+
+	if c.Stats {
+		value.Stats = pollStats(results, c.Denominator, c.denominator(results), c.Alpha)
+	}
+
+	return value, nil
+}
+
+// render prints one poll's results, or aggregated results, as the chart or
+// table with the optional statistics and participant breakdown.
+func (c *PollResultsCmd) render(f *output.Formatter, value *pollResultsJSON) error {
+	results := value.PollResults
+	total := c.denominator(results)
+
+	if f.UsesValue() {
+		if err := f.Output(value, nil, nil); err != nil {
+			return err
+		}
+	} else if showChart(c.Chart, f) {
+		bars := resultsBars(results, total)
+		if value.Weights != nil {
+			bars = weightedBars(value.Weights)
+		}
+
+		if err := f.Chart(bars); err != nil {
+			return err
+		}
+	} else {
+		headers, rows := c.table(f, value)
+		if err := f.Output(value, headers, rows); err != nil {
+			return err
+		}
+	}
+
+	// The summary would break CSV and other machine-readable tables.
+	if value.Stats != nil && f.Mode == output.ModeTable && !f.UsesValue() {
+		fmt.Fprintln(f.Writer)
+
+		if err := f.OutputSingle(value.Stats, statsSummary(value.Stats)); err != nil {
+			return err
+		}
 	}
 
 	if c.Participants && len(results.PollParticipants) > 0 {
-		fmt.Fprintln(os.Stdout)
+		fmt.Fprintln(f.Writer)
 
-		pHeaders, pRows := participantsTable(results)
-		if err := f.Output(results.PollParticipants, pHeaders, pRows); err != nil {
+		pHeaders, pRows := participantsTable(results, value.weights)
+		if err := f.OutputGrid(results.PollParticipants, pHeaders, pRows, "Option"); err != nil {
 			return err
 		}
 	}
@@ -53,8 +262,67 @@ func (c *PollResultsCmd) Run(flags *RootFlags) error {
 	return nil
 }
 
-func resultsTable(r *api.PollResults) ([]string, [][]string) {
-	total := r.VoteCount
+// table builds the results table of one poll, or of aggregated results,
+// with the columns the flags add.
+func (c *PollResultsCmd) table(f *output.Formatter, value *pollResultsJSON) ([]string, [][]string) {
+	headers, rows := resultsTable(value.PollResults, c.denominator(value.PollResults))
+	if value.Stats != nil {
+		headers, rows = withIntervals(headers, rows, value.Stats)
+	}
+
+	index := optionIndex(len(rows))
+	rankCol := 1
+
+	if value.Weights != nil {
+		rankCol = len(headers)
+		headers, rows = withWeighted(headers, rows, index, value.Weights, true)
+	}
+
+	if value.Polls != nil {
+		headers, rows = withBreakdown(headers, rows, index, value.Polls)
+	}
+
+	headers, rows = withWinners(headers, rows, index, value.winnersJSON)
+
+	if f.Accessible {
+		headers, rows = withRank(headers, rows, rankCol)
+	}
+
+	return headers, rows
+}
+
+// combinedTable lists the results of several polls in one table, with a
+// leading Poll column, so CSV and other data modes stay one table.
+func (c *PollResultsCmd) combinedTable(f *output.Formatter, values []*pollResultsJSON) ([]string, [][]string) {
+	var (
+		headers []string
+		rows    [][]string
+	)
+
+	for _, value := range values {
+		h, r := c.table(f, value)
+		headers = append([]string{"Poll"}, h...)
+
+		for _, row := range r {
+			rows = append(rows, append([]string{value.ID}, row...))
+		}
+	}
+
+	return headers, rows
+}
+
+// optionIndex maps rows in poll order to their option indexes.
+func optionIndex(n int) []int {
+	index := make([]int, n)
+	for i := range index {
+		index[i] = i
+	}
+
+	return index
+}
+
+// resultsTable lists each option's votes and their percentage of total.
+func resultsTable(r *api.PollResults, total int) ([]string, [][]string) {
 	headers := []string{"Option", "Votes", "Percentage"}
 	rows := make([][]string, 0, len(r.PollOptions))
 
@@ -74,11 +342,90 @@ func resultsTable(r *api.PollResults) ([]string, [][]string) {
 	return headers, rows
 }
 
-func participantsTable(r *api.PollResults) ([]string, [][]string) {
+// showChart reports whether --chart applies: charts replace the table in
+// table and plain modes, while data formats, templates, field selection
+// and accessible output keep their usual output.
+func showChart(chart bool, f *output.Formatter) bool {
+	if !chart || f.Template != nil || len(f.Fields) > 0 || f.Accessible {
+		return false
+	}
+
+	return f.Mode == output.ModeTable || f.Mode == output.ModePlain
+}
+
+// withRank appends a Rank column ordering rows by the number in column col,
+// highest first; equal numbers share a rank. Accessible output reads it as
+// "rank 1 of 3".
+func withRank(headers []string, rows [][]string, col int) ([]string, [][]string) {
+	value := func(row []string) float64 {
+		if col >= len(row) {
+			return 0
+		}
+
+		v, _ := strconv.ParseFloat(row[col], 64)
+
+		return v
+	}
+
+	ranked := make([][]string, len(rows))
+
+	for i, row := range rows {
+		rank := 1
+		for _, other := range rows {
+			if value(other) > value(row) {
+				rank++
+			}
+		}
+
+		ranked[i] = append(slices.Clip(row), strconv.Itoa(rank))
+	}
+
+	return append(slices.Clip(headers), "Rank"), ranked
+}
+
+// resultsBars builds one chart bar per option, in poll order, with the
+// percentage of total.
+func resultsBars(r *api.PollResults, total int) []output.Bar {
+	bars := make([]output.Bar, 0, len(r.PollOptions))
+
+	for _, opt := range r.PollOptions {
+		bars = append(bars, output.Bar{
+			Label:   opt.Value,
+			Value:   float64(opt.VoteCount),
+			Display: fmt.Sprintf("%d  %5.1f%%", opt.VoteCount, percent(opt.VoteCount, total)),
+		})
+	}
+
+	return bars
+}
+
+// weightedBars builds one chart bar per option, in poll order, with the
+// weighted count and the raw vote count.
+func weightedBars(report *weightsJSON) []output.Bar {
+	bars := make([]output.Bar, 0, len(report.Options))
+
+	for _, opt := range report.Options {
+		bars = append(bars, output.Bar{
+			Label:   opt.Value,
+			Value:   opt.Weighted,
+			Display: fmt.Sprintf("%s  %5.1f%%  (%s votes)", formatWeight(opt.Weighted), opt.Percentage, formatScore(opt.Raw)),
+		})
+	}
+
+	return bars
+}
+
+// participantsTable marks each participant's choices. With weights, a
+// Weight column is added and choices show the participant's weight.
+func participantsTable(r *api.PollResults, weights []float64) ([]string, [][]string) {
 	// Build header: Name + each option value
-	headers := make([]string, 0, 1+len(r.PollOptions))
+	headers := make([]string, 0, 2+len(r.PollOptions))
 	headers = append(headers, "Name")
 
+	if weights != nil {
+		headers = append(headers, "Weight")
+	}
+
 	for _, opt := range r.PollOptions {
 		headers = append(headers, opt.Value)
 	}
@@ -94,12 +441,18 @@ func participantsTable(r *api.PollResults) ([]string, [][]string) {
 
 		row = append(row, name)
 
+		mark := "x"
+		if weights != nil {
+			mark = formatWeight(weights[len(rows)])
+			row = append(row, mark)
+		}
+
 		// PollVotes contains pointers to option indices the participant voted for
 		voted := voteSet(p.PollVotes)
 
 		for i := range r.PollOptions {
 			if voted[i] {
-				row = append(row, "x")
+				row = append(row, mark)
 			} else {
 				row = append(row, strings.Repeat(" ", 1))
 			}
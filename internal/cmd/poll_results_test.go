@@ -0,0 +1,43 @@
+package cmd
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+
+	"github.com/dedene/strawpoll-cli/internal/output"
+)
+
+func TestCombinedTable(t *testing.T) {
+	c := &PollResultsCmd{Denominator: "votes"}
+	f := output.NewFormatterMode(&bytes.Buffer{}, output.ModeCSV, true)
+
+	var values []*pollResultsJSON
+
+	for _, p := range []fetchedPoll{
+		aggregateFixture("p1", []string{"Pizza", "Sushi"}, []int{0}, []int{1}, []int{1}),
+		aggregateFixture("p2", []string{"Tacos"}, []int{0}),
+	} {
+		value, err := c.resultsValue(p.results, p.poll, nil)
+		if err != nil {
+			t.Fatalf("resultsValue: %v", err)
+		}
+
+		values = append(values, value)
+	}
+
+	headers, rows := c.combinedTable(f, values)
+
+	if want := []string{"Poll", "Option", "Votes", "Percentage", "Winner"}; !reflect.DeepEqual(headers, want) {
+		t.Errorf("headers = %v, want %v", headers, want)
+	}
+
+	want := [][]string{
+		{"p1", "Pizza", "1", "33.3%", ""},
+		{"p1", "Sushi", "2", "66.7%", "x"},
+		{"p2", "Tacos", "1", "100.0%", "x"},
+	}
+	if !reflect.DeepEqual(rows, want) {
+		t.Errorf("rows = %v, want %v", rows, want)
+	}
+}
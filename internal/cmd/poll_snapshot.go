@@ -0,0 +1,122 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/dedene/strawpoll-cli/internal/api"
+	"github.com/dedene/strawpoll-cli/internal/config"
+	"github.com/dedene/strawpoll-cli/internal/output"
+	"github.com/dedene/strawpoll-cli/internal/snapshot"
+)
+
+// PollSnapshotCmd saves a poll's current results for a later poll diff.
+type PollSnapshotCmd struct {
+	ID   string `arg:"" required:"" help:"Poll ID or URL"`
+	List bool   `help:"List saved snapshots instead of taking one" short:"l"`
+}
+
+// snapshotStore opens the snapshot store under the config directory.
+func snapshotStore() (*snapshot.Store, error) {
+	dir, err := config.SnapshotDir()
+	if err != nil {
+		return nil, err
+	}
+
+	return &snapshot.Store{Dir: dir}, nil
+}
+
+// Run saves the poll's results, or lists the saved snapshots with --list.
+func (c *PollSnapshotCmd) Run(flags *RootFlags) error {
+	id := api.ParsePollID(c.ID)
+
+	store, err := snapshotStore()
+	if err != nil {
+		return err
+	}
+
+	if c.List {
+		return listSnapshots(newFormatter(flags), store, id)
+	}
+
+	client, err := newClientFromAuth()
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	results, err := client.GetPollResults(context.Background(), id)
+	if err != nil {
+		return err
+	}
+
+	snap := &snapshot.Snapshot{PollID: id, TakenAt: time.Now().UTC(), Results: results}
+
+	path, err := store.Save(snap)
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintf(os.Stderr, "Snapshot saved to %s\n", path)
+
+	f := newFormatter(flags)
+
+	return f.OutputSingle(snap, [][2]string{
+		{"Poll", id},
+		{"Snapshot", snap.Name()},
+		{"Taken", output.Times().DateTime(snap.TakenAt.Local())},
+		{"Participants", strconv.Itoa(results.ParticipantCount)},
+		{"Votes", strconv.Itoa(results.VoteCount)},
+	})
+}
+
+// snapshotListJSON is one row of poll snapshot --list.
+type snapshotListJSON struct {
+	Name         string    `json:"name"`
+	TakenAt      time.Time `json:"takenAt"`
+	Participants int       `json:"participants"`
+	Votes        int       `json:"votes"`
+}
+
+// listSnapshots shows the saved snapshots of a poll, oldest first.
+func listSnapshots(f *output.Formatter, store *snapshot.Store, id string) error {
+	entries, err := store.List(id)
+	if err != nil {
+		return err
+	}
+
+	list := make([]snapshotListJSON, 0, len(entries))
+	rows := make([][]string, 0, len(entries))
+
+	for _, e := range entries {
+		s, err := store.Load(e)
+		if err != nil {
+			return err
+		}
+
+		item := snapshotListJSON{Name: e.Name, TakenAt: e.TakenAt}
+		if s.Results != nil {
+			item.Participants = s.Results.ParticipantCount
+			item.Votes = s.Results.VoteCount
+		}
+
+		list = append(list, item)
+		rows = append(rows, []string{
+			item.Name,
+			output.Times().DateTime(item.TakenAt.Local()),
+			strconv.Itoa(item.Participants),
+			strconv.Itoa(item.Votes),
+		})
+	}
+
+	if len(entries) == 0 && !f.UsesValue() {
+		fmt.Fprintf(os.Stderr, "No snapshots of %s; take one with: strawpoll poll snapshot %s\n", id, id)
+
+		return nil
+	}
+
+	return f.Output(list, []string{"Snapshot", "Taken", "Participants", "Votes"}, rows)
+}
@@ -0,0 +1,162 @@
+package cmd
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+
+	"github.com/dedene/strawpoll-cli/internal/api"
+	"github.com/dedene/strawpoll-cli/internal/stats"
+)
+
+// statsJSON is the --stats section of poll results.
+type statsJSON struct {
+	// Denominator names the base of every share: votes or participants.
+	Denominator  string            `json:"denominator"`
+	Participants int               `json:"participants"`
+	Votes        int               `json:"votes"`
+	Alpha        float64           `json:"alpha"`
+	Options      []optionStatsJSON `json:"options"`
+	Lead         *leadJSON         `json:"lead,omitempty"`
+}
+
+// optionStatsJSON holds an option's share and its Wilson score interval,
+// as percentages.
+type optionStatsJSON struct {
+	Value string  `json:"value"`
+	Share float64 `json:"share"`
+	Lower float64 `json:"lower"`
+	Upper float64 `json:"upper"`
+}
+
+// leadJSON tests the gap between the first and second placed options.
+type leadJSON struct {
+	First       string  `json:"first"`
+	Second      string  `json:"second"`
+	Margin      float64 `json:"margin"`
+	Z           float64 `json:"z"`
+	PValue      float64 `json:"pValue"`
+	Significant bool    `json:"significant"`
+}
+
+// pollStats computes confidence intervals for each option's share of total
+// and tests the leader's margin over the runner-up.
+func pollStats(r *api.PollResults, denominator string, total int, alpha float64) *statsJSON {
+	st := &statsJSON{
+		Denominator:  denominator,
+		Participants: r.ParticipantCount,
+		Votes:        r.VoteCount,
+		Alpha:        alpha,
+	}
+
+	for _, opt := range r.PollOptions {
+		lo, hi := stats.Wilson(opt.VoteCount, total, alpha)
+		st.Options = append(st.Options, optionStatsJSON{
+			Value: opt.Value,
+			Share: percent(opt.VoteCount, total),
+			Lower: lo * 100,
+			Upper: hi * 100,
+		})
+	}
+
+	if len(r.PollOptions) < 2 {
+		return st
+	}
+
+	order := optionIndex(len(r.PollOptions))
+	sort.SliceStable(order, func(a, b int) bool {
+		return r.PollOptions[order[a]].VoteCount > r.PollOptions[order[b]].VoteCount
+	})
+
+	first, second := order[0], order[1]
+	onlyFirst, onlySecond := leadVoters(r, first, second)
+	lead := stats.TestLead(onlyFirst, onlySecond, alpha)
+
+	st.Lead = &leadJSON{
+		First:       r.PollOptions[first].Value,
+		Second:      r.PollOptions[second].Value,
+		Margin:      st.Options[first].Share - st.Options[second].Share,
+		Z:           lead.Z,
+		PValue:      lead.PValue,
+		Significant: lead.Significant,
+	}
+
+	return st
+}
+
+// leadVoters counts the participants who chose first but not second, and
+// second but not first. Without visible participants it falls back to the
+// vote counts, which is exact for single-choice polls and conservative for
+// multi-select ones.
+func leadVoters(r *api.PollResults, first, second int) (int, int) {
+	if len(r.PollParticipants) == 0 {
+		return r.PollOptions[first].VoteCount, r.PollOptions[second].VoteCount
+	}
+
+	onlyFirst, onlySecond := 0, 0
+
+	for _, p := range r.PollParticipants {
+		voted := voteSet(p.PollVotes)
+
+		switch {
+		case voted[first] && !voted[second]:
+			onlyFirst++
+		case voted[second] && !voted[first]:
+			onlySecond++
+		}
+	}
+
+	return onlyFirst, onlySecond
+}
+
+// confidenceLabel names the interval column, e.g. "95% CI".
+func confidenceLabel(alpha float64) string {
+	return fmt.Sprintf("%.4g%% CI", (1-alpha)*100)
+}
+
+// withIntervals adds the confidence interval column after Percentage.
+func withIntervals(headers []string, rows [][]string, st *statsJSON) ([]string, [][]string) {
+	out := make([][]string, len(rows))
+	for i, row := range rows {
+		o := st.Options[i]
+		out[i] = append(row[:3:3], fmt.Sprintf("%.1f–%.1f%%", o.Lower, o.Upper))
+		out[i] = append(out[i], row[3:]...)
+	}
+
+	return append(headers[:3:3], append([]string{confidenceLabel(st.Alpha)}, headers[3:]...)...), out
+}
+
+// statsSummary describes the denominator and the significance of the lead.
+func statsSummary(st *statsJSON) [][2]string {
+	base := st.Denominator
+	if st.Denominator == "votes" && st.Votes > st.Participants {
+		base += " (participants could choose several options)"
+	}
+
+	pairs := [][2]string{
+		{"Participants", strconv.Itoa(st.Participants)},
+		{"Votes", strconv.Itoa(st.Votes)},
+		{"Percentages of", base},
+	}
+
+	if st.Lead == nil {
+		return pairs
+	}
+
+	verdict := "not significant"
+	if st.Lead.Significant {
+		verdict = "significant"
+	}
+
+	return append(pairs, [2]string{"Lead", fmt.Sprintf("%s over %s by %.1f points: %s at alpha %g (p = %s)",
+		st.Lead.First, st.Lead.Second, st.Lead.Margin, verdict, st.Alpha, formatPValue(st.Lead.PValue))})
+}
+
+func formatPValue(p float64) string {
+	if p < 0.001 {
+		return "< 0.001"
+	}
+
+	return strconv.FormatFloat(math.Round(p*1000)/1000, 'f', -1, 64)
+}
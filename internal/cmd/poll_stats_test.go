@@ -0,0 +1,176 @@
+package cmd
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/dedene/strawpoll-cli/internal/api"
+)
+
+// statsFixture builds results whose option vote counts follow from the
+// participants' ballots, each a list of option indices.
+func statsFixture(values []string, ballots [][]int) *api.PollResults {
+	r := &api.PollResults{ParticipantCount: len(ballots)}
+	for _, v := range values {
+		r.PollOptions = append(r.PollOptions, &api.PollOption{Value: v})
+	}
+
+	for _, b := range ballots {
+		p := &api.PollParticipant{}
+		for _, i := range b {
+			p.PollVotes = append(p.PollVotes, &i)
+			r.PollOptions[i].VoteCount++
+			r.VoteCount++
+		}
+
+		r.PollParticipants = append(r.PollParticipants, p)
+	}
+
+	return r
+}
+
+// singleChoice has Sushi ahead of Tacos, with Pizza last despite being
+// listed first.
+func singleChoice() *api.PollResults {
+	return statsFixture([]string{"Pizza", "Sushi", "Tacos"},
+		[][]int{{0}, {1}, {1}, {1}, {2}, {2}})
+}
+
+// multiSelect has five participants casting eight votes; two chose Pizza
+// without Sushi and one Sushi without Pizza.
+func multiSelect() *api.PollResults {
+	return statsFixture([]string{"Pizza", "Sushi", "Tacos"},
+		[][]int{{0, 1}, {0, 1}, {0}, {0, 2}, {1}})
+}
+
+func TestPollStats(t *testing.T) {
+	tests := []struct {
+		name          string
+		results       *api.PollResults
+		denominator   string
+		total         int
+		first, second string
+		shares        []float64
+		margin        float64
+	}{
+		{
+			name: "single choice", results: singleChoice(), denominator: "votes", total: 6,
+			first: "Sushi", second: "Tacos", shares: []float64{100.0 / 6, 50, 100.0 / 3}, margin: 100.0 / 6,
+		},
+		{
+			name: "multi-select of participants", results: multiSelect(), denominator: "participants", total: 5,
+			first: "Pizza", second: "Sushi", shares: []float64{80, 60, 20}, margin: 20,
+		},
+		{
+			name: "multi-select of votes", results: multiSelect(), denominator: "votes", total: 8,
+			first: "Pizza", second: "Sushi", shares: []float64{50, 37.5, 12.5}, margin: 12.5,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			st := pollStats(tt.results, tt.denominator, tt.total, 0.05)
+
+			if st.Denominator != tt.denominator || st.Participants != tt.results.ParticipantCount || st.Votes != tt.results.VoteCount {
+				t.Errorf("header = %s/%d/%d", st.Denominator, st.Participants, st.Votes)
+			}
+
+			for i, o := range st.Options {
+				if !approx(o.Share, tt.shares[i]) {
+					t.Errorf("%s share = %v, want %v", o.Value, o.Share, tt.shares[i])
+				}
+
+				if o.Lower > o.Share || o.Upper < o.Share || o.Lower < 0 || o.Upper > 100 {
+					t.Errorf("%s interval %v–%v does not bracket %v", o.Value, o.Lower, o.Upper, o.Share)
+				}
+			}
+
+			if st.Lead == nil {
+				t.Fatal("Lead = nil")
+			}
+
+			if st.Lead.First != tt.first || st.Lead.Second != tt.second {
+				t.Errorf("lead = %s over %s, want %s over %s", st.Lead.First, st.Lead.Second, tt.first, tt.second)
+			}
+
+			if !approx(st.Lead.Margin, tt.margin) {
+				t.Errorf("Margin = %v, want %v", st.Lead.Margin, tt.margin)
+			}
+		})
+	}
+}
+
+func TestPollStats_SingleOption(t *testing.T) {
+	st := pollStats(statsFixture([]string{"Yes"}, [][]int{{0}, {0}}), "votes", 2, 0.05)
+
+	if st.Lead != nil {
+		t.Errorf("Lead = %+v, want none", st.Lead)
+	}
+
+	if len(st.Options) != 1 || st.Options[0].Share != 100 {
+		t.Errorf("Options = %+v", st.Options)
+	}
+}
+
+func TestLeadVoters(t *testing.T) {
+	hidden := multiSelect()
+	hidden.PollParticipants = nil
+
+	tests := []struct {
+		name          string
+		results       *api.PollResults
+		first, second int
+		wantFirst     int
+		wantSecond    int
+	}{
+		{name: "single choice", results: singleChoice(), first: 1, second: 2, wantFirst: 3, wantSecond: 2},
+		// Participants who chose both do not count towards either side.
+		{name: "multi-select", results: multiSelect(), first: 0, second: 1, wantFirst: 2, wantSecond: 1},
+		{name: "hidden participants", results: hidden, first: 0, second: 1, wantFirst: 4, wantSecond: 3},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a, b := leadVoters(tt.results, tt.first, tt.second)
+			if a != tt.wantFirst || b != tt.wantSecond {
+				t.Errorf("leadVoters = %d, %d, want %d, %d", a, b, tt.wantFirst, tt.wantSecond)
+			}
+		})
+	}
+}
+
+func TestWithIntervals(t *testing.T) {
+	st := &statsJSON{
+		Alpha: 0.05,
+		Options: []optionStatsJSON{
+			{Value: "Pizza", Lower: 10, Upper: 30.25},
+			{Value: "Sushi", Lower: 40.04, Upper: 60},
+		},
+	}
+	headers := []string{"Option", "Votes", "Percentage", "Winner"}
+	rows := [][]string{{"Pizza", "2", "20.0%", ""}, {"Sushi", "5", "50.0%", "x"}}
+
+	gotHeaders, gotRows := withIntervals(headers, rows, st)
+
+	wantHeaders := []string{"Option", "Votes", "Percentage", "95% CI", "Winner"}
+	if !reflect.DeepEqual(gotHeaders, wantHeaders) {
+		t.Errorf("headers = %v, want %v", gotHeaders, wantHeaders)
+	}
+
+	wantRows := [][]string{
+		{"Pizza", "2", "20.0%", "10.0–30.2%", ""},
+		{"Sushi", "5", "50.0%", "40.0–60.0%", "x"},
+	}
+	if !reflect.DeepEqual(gotRows, wantRows) {
+		t.Errorf("rows = %v, want %v", gotRows, wantRows)
+	}
+
+	if headers[3] != "Winner" || rows[0][3] != "" {
+		t.Error("withIntervals modified its input")
+	}
+}
+
+func approx(a, b float64) bool {
+	d := a - b
+	return d < 1e-9 && d > -1e-9
+}
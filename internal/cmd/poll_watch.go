@@ -0,0 +1,253 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/signal"
+	"strings"
+	"time"
+
+	"github.com/dedene/strawpoll-cli/internal/api"
+	"github.com/dedene/strawpoll-cli/internal/output"
+	"github.com/dedene/strawpoll-cli/internal/tui"
+)
+
+// PollWatchCmd shows a live-updating results dashboard for any poll type.
+type PollWatchCmd struct {
+	ID       string        `arg:"" required:"" help:"Poll ID or URL"`
+	Interval time.Duration `help:"Refresh interval" default:"5s"`
+	Count    int           `help:"Stop after this many snapshots when not on a terminal (0 = until interrupted)"`
+}
+
+// Run starts the dashboard on a terminal in table mode, prints each
+// refresh as sentences with --accessible, or streams NDJSON snapshots
+// otherwise.
+func (c *PollWatchCmd) Run(flags *RootFlags) error {
+	if c.Interval < time.Second {
+		return &ExitError{Code: CodeUsage, Err: fmt.Errorf("--interval must be at least 1s")}
+	}
+
+	id := api.ParsePollID(c.ID)
+
+	client, err := newClientFromAuth()
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	poll, err := client.GetPoll(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	fetch := func(ctx context.Context) (*tui.WatchSnapshot, error) {
+		results, err := client.GetPollResults(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+
+		return watchSnapshot(poll, results, time.Now()), nil
+	}
+
+	if flags.OutputMode() == output.ModeTable && flags.Accessible {
+		return c.stream(ctx, fetch, func(snap *tui.WatchSnapshot) error {
+			_, err := fmt.Fprintln(os.Stdout, tui.DescribeSnapshot(snap))
+
+			return err
+		})
+	}
+
+	if tui.IsOutputTerminal() && flags.OutputMode() == output.ModeTable {
+		return tui.RunWatch(ctx, fetch, c.Interval)
+	}
+
+	enc := json.NewEncoder(os.Stdout)
+	enc.SetEscapeHTML(false)
+
+	return c.stream(ctx, fetch, func(snap *tui.WatchSnapshot) error { return enc.Encode(snap) })
+}
+
+// stream emits a snapshot per refresh until interrupted or --count is
+// reached, backing off on errors.
+func (c *PollWatchCmd) stream(ctx context.Context, fetch tui.WatchFetchFunc, emit func(*tui.WatchSnapshot) error) error {
+	var prev *tui.WatchSnapshot
+
+	wait := c.Interval
+
+	for n := 0; c.Count == 0 || n < c.Count; {
+		snap, err := fetch(ctx)
+
+		switch {
+		case ctx.Err() != nil:
+			return nil
+		case err != nil:
+			fmt.Fprintf(os.Stderr, "watch: %v\n", err)
+
+			wait = min(wait*2, 5*time.Minute)
+		default:
+			tui.ApplyDeltas(prev, snap)
+			prev = snap
+			wait = c.Interval
+			n++
+
+			if err := emit(snap); err != nil {
+				return err
+			}
+
+			if c.Count > 0 && n >= c.Count {
+				return nil
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(wait):
+		}
+	}
+
+	return nil
+}
+
+// watchSnapshot converts results into dashboard rows appropriate for the poll type:
+// vote counts for multiple choice, Borda scores for ranking, and "yes"
+// availability for meeting polls.
+func watchSnapshot(poll *api.Poll, results *api.PollResults, now time.Time) *tui.WatchSnapshot {
+	snap := &tui.WatchSnapshot{
+		ID:           poll.ID,
+		Title:        poll.Title,
+		Type:         poll.Type,
+		Participants: results.ParticipantCount,
+		Votes:        results.VoteCount,
+		FetchedAt:    now,
+	}
+
+	if poll.PollConfig != nil && poll.PollConfig.DeadlineAt != nil {
+		deadline := time.Unix(*poll.PollConfig.DeadlineAt, 0)
+		snap.Deadline = &deadline
+	}
+
+	switch poll.Type {
+	case api.PollTypeRanking:
+		snap.Unit = "points"
+		scores := bordaScores(results)
+		maxScore := len(results.PollOptions) * len(results.PollParticipants)
+
+		for i, opt := range results.PollOptions {
+			snap.Rows = append(snap.Rows, tui.WatchRow{
+				Label:   opt.Value,
+				Value:   scores[i],
+				Percent: percent(scores[i], maxScore),
+			})
+		}
+
+	case api.PollTypeMeeting:
+		snap.Unit = "availability"
+		loc := meetingLocation(poll)
+		total := len(results.PollParticipants)
+
+		for i, opt := range results.PollOptions {
+			yes := availabilityScore(results.PollParticipants, i) / 1000
+			snap.Rows = append(snap.Rows, tui.WatchRow{
+				Label:   formatTimeslot(opt, loc),
+				Value:   yes,
+				Percent: percent(yes, total),
+				Detail:  availabilitySummary(results.PollParticipants, i),
+			})
+		}
+
+	default:
+		snap.Unit = "votes"
+
+		for _, opt := range results.PollOptions {
+			snap.Rows = append(snap.Rows, tui.WatchRow{
+				Label:   opt.Value,
+				Value:   opt.VoteCount,
+				Percent: percent(opt.VoteCount, results.VoteCount),
+			})
+		}
+	}
+
+	for _, p := range results.PollParticipants {
+		snap.Voters = append(snap.Voters, tui.WatchVoter{Name: participantName(p), Summary: ballotSummary(poll, results, p)})
+	}
+
+	return snap
+}
+
+// ballotSummary renders a participant's ballot for the poll type: the
+// ranking, the slots they are available for, or their choices.
+func ballotSummary(poll *api.Poll, results *api.PollResults, p *api.PollParticipant) string {
+	switch poll.Type {
+	case api.PollTypeRanking:
+		return rankingBallot(results, p)
+
+	case api.PollTypeMeeting:
+		loc := meetingLocation(poll)
+
+		var slots []string
+
+		for i, opt := range results.PollOptions {
+			if i < len(p.PollVotes) && p.PollVotes[i] != nil && *p.PollVotes[i] == 1 {
+				slots = append(slots, formatTimeslot(opt, loc))
+			}
+		}
+
+		return strings.Join(slots, ", ")
+
+	default:
+		voted := voteSet(p.PollVotes)
+
+		var choices []string
+
+		for i, opt := range results.PollOptions {
+			if voted[i] {
+				choices = append(choices, opt.Value)
+			}
+		}
+
+		return strings.Join(choices, ", ")
+	}
+}
+
+// rankingBallot renders a participant's ranking as "A > B > C".
+func rankingBallot(results *api.PollResults, p *api.PollParticipant) string {
+	n := len(results.PollOptions)
+	byPos := make([]string, n)
+
+	for i, v := range p.PollVotes {
+		if v != nil && i < n && *v >= 0 && *v < n {
+			byPos[*v] = results.PollOptions[i].Value
+		}
+	}
+
+	ranked := make([]string, 0, n)
+	for _, v := range byPos {
+		if v != "" {
+			ranked = append(ranked, v)
+		}
+	}
+
+	return strings.Join(ranked, " > ")
+}
+
+func participantName(p *api.PollParticipant) string {
+	if p.Name == "" {
+		return "Anonymous"
+	}
+
+	return p.Name
+}
+
+func percent(part, total int) float64 {
+	if total <= 0 {
+		return 0
+	}
+
+	return float64(part) / float64(total) * 100
+}
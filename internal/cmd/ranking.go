@@ -5,6 +5,8 @@ type RankingCmd struct {
 	Create  RankingCreateCmd  `cmd:"" help:"Create a ranking poll"`
 	Get     RankingGetCmd     `cmd:"" help:"Get ranking poll details"`
 	Results RankingResultsCmd `cmd:"" help:"View ranking results"`
+	Export  RankingExportCmd  `cmd:"" help:"Export ballots for external counting tools"`
+	Watch   PollWatchCmd      `cmd:"" help:"Live-updating ranking dashboard"`
 	Delete  RankingDeleteCmd  `cmd:"" help:"Delete a ranking poll"`
 	Update  RankingUpdateCmd  `cmd:"" help:"Update a ranking poll"`
 	List    RankingListCmd    `cmd:"" help:"List ranking polls"`
@@ -4,13 +4,13 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"time"
 
 	"github.com/atotto/clipboard"
 	"github.com/pkg/browser"
 
 	"github.com/dedene/strawpoll-cli/internal/api"
 	"github.com/dedene/strawpoll-cli/internal/config"
-	"github.com/dedene/strawpoll-cli/internal/output"
 )
 
 // RankingCreateCmd creates a ranking poll.
@@ -33,21 +33,20 @@ type RankingCreateCmd struct {
 
 // Run creates a ranking poll via the API.
 func (c *RankingCreateCmd) Run(flags *RootFlags) error {
-	if len(c.Options) < 2 || len(c.Options) > 30 {
-		return fmt.Errorf("ranking poll requires 2-30 options, got %d", len(c.Options))
-	}
-
 	cfg, _ := config.ReadConfig()
 	c.applyDefaults(cfg)
 
-	client, err := newClientFromAuth()
+	req := c.buildRequest()
+	if err := api.ValidateCreatePollRequest(req, time.Now()); err != nil {
+		return &ExitError{Code: CodeUsage, Err: err}
+	}
+
+	client, err := newWriteClient(flags)
 	if err != nil {
 		return err
 	}
 	defer client.Close()
 
-	req := c.buildRequest()
-
 	poll, err := client.CreatePoll(context.Background(), req)
 	if err != nil {
 		return err
@@ -55,7 +54,7 @@ func (c *RankingCreateCmd) Run(flags *RootFlags) error {
 
 	pollURL := pollBaseURL + poll.ID
 
-	f := output.NewFormatter(os.Stdout, flags.JSON, flags.Plain, flags.NoColor)
+	f := newFormatter(flags)
 	if err := f.OutputSingle(poll, [][2]string{
 		{"ID", poll.ID},
 		{"Title", poll.Title},
@@ -16,10 +16,10 @@ type RankingDeleteCmd struct {
 }
 
 // Run deletes a ranking poll, prompting for confirmation unless --force.
-func (c *RankingDeleteCmd) Run(_ *RootFlags) error {
+func (c *RankingDeleteCmd) Run(flags *RootFlags) error {
 	id := api.ParsePollID(c.ID)
 
-	if !c.Force {
+	if !c.Force && !flags.DryRun {
 		confirmed, err := tui.Confirm(fmt.Sprintf("Delete ranking poll %s? This cannot be undone.", id))
 		if err != nil {
 			return err
@@ -32,7 +32,7 @@ func (c *RankingDeleteCmd) Run(_ *RootFlags) error {
 		}
 	}
 
-	client, err := newClientFromAuth()
+	client, err := newWriteClient(flags)
 	if err != nil {
 		return err
 	}
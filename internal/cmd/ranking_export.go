@@ -0,0 +1,109 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/dedene/strawpoll-cli/internal/api"
+	"github.com/dedene/strawpoll-cli/internal/ranking"
+)
+
+// RankingExportCmd writes a ranking poll's ballots in a standard election
+// format for external counting tools.
+type RankingExportCmd struct {
+	ID     string `arg:"" required:"" help:"Poll ID or URL"`
+	Format string `help:"Ballot format: blt, preflib-soi, preflib-toi or csv-ballots (inferred from a .blt, .soi, .toi or .csv output file if omitted)"`
+	File   string `help:"Output file (default: stdout)" type:"path"`
+}
+
+// Run fetches the poll and its ballots and writes the export.
+func (c *RankingExportCmd) Run(flags *RootFlags) error {
+	format, err := ranking.ParseExportFormat(c.Format, c.File)
+	if err != nil {
+		return &ExitError{Code: CodeUsage, Err: err}
+	}
+
+	id := api.ParsePollID(c.ID)
+
+	client, err := newClientFromAuth()
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	ctx := context.Background()
+
+	poll, err := client.GetPoll(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if poll.Type != api.PollTypeRanking {
+		return &ExitError{Code: CodeUsage, Err: fmt.Errorf("poll %s is a %s poll, not a ranking poll", poll.ID, friendlyType(poll.Type))}
+	}
+
+	results, err := client.GetPollResults(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	election := ballotElection(poll, results, time.Now())
+
+	if err := writeExport(c.File, election, format); err != nil {
+		return err
+	}
+
+	if c.File == "" {
+		return nil
+	}
+
+	fmt.Fprintf(os.Stderr, "Exported %d ballots from %s to %s\n", len(election.Ballots), poll.ID, c.File)
+
+	return nil
+}
+
+// writeExport writes the ballots to path, or to stdout when path is
+// empty. They are formatted in memory first, so a format that cannot hold
+// them (such as ties in preflib-soi) leaves no partial output behind.
+func writeExport(path string, election *ranking.Election, format ranking.ExportFormat) error {
+	var buf bytes.Buffer
+	if err := ranking.WriteBallots(&buf, election, format); err != nil {
+		return fmt.Errorf("write export: %w", err)
+	}
+
+	if path == "" {
+		_, err := buf.WriteTo(os.Stdout)
+
+		return err
+	}
+
+	if err := os.WriteFile(path, buf.Bytes(), 0o666); err != nil { //nolint:gosec // same mode as os.Create
+		return fmt.Errorf("write export: %w", err)
+	}
+
+	return nil
+}
+
+// ballotElection collects one preference per participant. Participants
+// who ranked nothing keep an empty ballot.
+func ballotElection(poll *api.Poll, results *api.PollResults, now time.Time) *ranking.Election {
+	e := &ranking.Election{
+		Title: poll.Title,
+		Seats: (&WinnerFlags{}).winnerCount(poll),
+		Date:  now,
+	}
+
+	for _, opt := range results.PollOptions {
+		e.Options = append(e.Options, opt.Value)
+	}
+
+	for _, p := range results.PollParticipants {
+		e.Voters = append(e.Voters, participantName(p))
+		e.Ballots = append(e.Ballots, ranking.PreferenceFromPositions(p.PollVotes))
+	}
+
+	return e
+}
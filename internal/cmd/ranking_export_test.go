@@ -0,0 +1,54 @@
+package cmd
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/dedene/strawpoll-cli/internal/ranking"
+)
+
+func TestWriteExport(t *testing.T) {
+	tied := &ranking.Election{
+		Title:   "Lunch",
+		Options: []string{"Pizza", "Sushi"},
+		Seats:   1,
+		Voters:  []string{"Alice"},
+		Ballots: []ranking.Preference{{{0, 1}}},
+	}
+	dir := t.TempDir()
+
+	// A format that cannot hold the ballots creates no file.
+	missing := filepath.Join(dir, "new.soi")
+	if err := writeExport(missing, tied, ranking.ExportPrefLibSOI); err == nil {
+		t.Fatal("writeExport(soi) with tied ranks: want error")
+	}
+
+	if _, err := os.Stat(missing); !errors.Is(err, os.ErrNotExist) {
+		t.Errorf("failed export left %s behind: %v", missing, err)
+	}
+
+	// Nor does it truncate an earlier export.
+	existing := filepath.Join(dir, "old.soi")
+	if err := os.WriteFile(existing, []byte("earlier"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := writeExport(existing, tied, ranking.ExportPrefLibSOI); err == nil {
+		t.Fatal("writeExport(soi) with tied ranks: want error")
+	}
+
+	if b, _ := os.ReadFile(existing); string(b) != "earlier" {
+		t.Errorf("failed export changed %s to %q", existing, b)
+	}
+
+	toi := filepath.Join(dir, "lunch.toi")
+	if err := writeExport(toi, tied, ranking.ExportPrefLibTOI); err != nil {
+		t.Fatalf("writeExport(toi) error: %v", err)
+	}
+
+	if info, err := os.Stat(toi); err != nil || info.Size() == 0 {
+		t.Errorf("writeExport(toi) wrote %v, %v", info, err)
+	}
+}
@@ -3,10 +3,8 @@ package cmd
 import (
 	"context"
 	"fmt"
-	"os"
 
 	"github.com/dedene/strawpoll-cli/internal/api"
-	"github.com/dedene/strawpoll-cli/internal/output"
 )
 
 // RankingGetCmd retrieves ranking poll details.
@@ -31,13 +29,13 @@ func (c *RankingGetCmd) Run(flags *RootFlags) error {
 
 	pollURL := pollBaseURL + poll.ID
 
-	f := output.NewFormatter(os.Stdout, flags.JSON, flags.Plain, flags.NoColor)
-	return f.OutputSingle(poll, [][2]string{
+	f := newFormatter(flags)
+	return f.OutputSingle(poll, append([][2]string{
 		{"ID", poll.ID},
 		{"Title", poll.Title},
 		{"Type", poll.Type},
 		{"URL", pollURL},
 		{"Options", fmt.Sprintf("%d", len(poll.PollOptions))},
 		{"Votes", voteCount(poll)},
-	})
+	}, pollTimes(poll)...))
 }
@@ -4,10 +4,8 @@ import (
 	"context"
 	"fmt"
 	"os"
-	"time"
 
 	"github.com/dedene/strawpoll-cli/internal/api"
-	"github.com/dedene/strawpoll-cli/internal/output"
 )
 
 // RankingListCmd lists the user's ranking polls.
@@ -37,8 +35,8 @@ func (c *RankingListCmd) Run(flags *RootFlags) error {
 		}
 	}
 
-	f := output.NewFormatter(os.Stdout, flags.JSON, flags.Plain, flags.NoColor)
-	headers := []string{"ID", "Title", "Options", "Votes", "Created"}
+	f := newFormatter(flags)
+	headers := []string{"ID", "Title", "Options", "Votes", "Created", "Closes"}
 
 	rows := make([][]string, 0, len(rankings))
 	for _, p := range rankings {
@@ -52,7 +50,8 @@ func (c *RankingListCmd) Run(flags *RootFlags) error {
 			p.Title,
 			fmt.Sprintf("%d", len(p.PollOptions)),
 			votes,
-			time.Unix(p.CreatedAt, 0).Format("2006-01-02"),
+			createdCell(p.CreatedAt),
+			closesCell(&p),
 		})
 	}
 
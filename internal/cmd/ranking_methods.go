@@ -0,0 +1,178 @@
+package cmd
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/dedene/strawpoll-cli/internal/api"
+	"github.com/dedene/strawpoll-cli/internal/output"
+	"github.com/dedene/strawpoll-cli/internal/ranking"
+)
+
+// rankingBallots converts each participant's positions into a ballot.
+func rankingBallots(results *api.PollResults) []ranking.Ballot {
+	ballots := make([]ranking.Ballot, 0, len(results.PollParticipants))
+	for _, p := range results.PollParticipants {
+		ballots = append(ballots, ranking.BallotFromPositions(p.PollVotes))
+	}
+
+	return ballots
+}
+
+func formatScore(v float64) string {
+	return strconv.FormatFloat(v, 'f', -1, 64)
+}
+
+// methodScoreTable lists options in the method's finishing order. The
+// order doubles as the row-to-option index.
+func methodScoreTable(results *api.PollResults, res ranking.Result) ([]string, [][]string, []int) {
+	headers := []string{"Option", res.Method.ScoreLabel()}
+	rows := make([][]string, 0, len(res.Order))
+
+	for _, i := range res.Order {
+		rows = append(rows, []string{results.PollOptions[i].Value, formatScore(res.Scores[i])})
+	}
+
+	return headers, rows, res.Order
+}
+
+// methodBars builds one chart bar per option in the method's finishing order.
+func methodBars(results *api.PollResults, res ranking.Result) []output.Bar {
+	bars := make([]output.Bar, 0, len(res.Order))
+	for _, i := range res.Order {
+		bars = append(bars, output.Bar{
+			Label:   results.PollOptions[i].Value,
+			Value:   res.Scores[i],
+			Display: formatScore(res.Scores[i]),
+		})
+	}
+
+	return bars
+}
+
+// explainRanking prints how the method reached its order: IRV rounds, the
+// Schulze winner's strongest paths, or the ranked-pairs locking sequence.
+func explainRanking(f *output.Formatter, results *api.PollResults, res ranking.Result) error {
+	switch {
+	case len(res.Rounds) > 0:
+		fmt.Fprintln(f.Writer)
+
+		headers, rows := irvRoundsTable(results, res)
+
+		return f.OutputGrid(res, headers, rows, "Round")
+	case len(res.Paths) > 0:
+		fmt.Fprintln(f.Writer)
+
+		headers, rows := schulzePathsTable(results, res)
+
+		return f.Output(res, headers, rows)
+	case len(res.Pairs) > 0:
+		fmt.Fprintln(f.Writer)
+
+		headers, rows := rankedPairsTable(results, res)
+
+		return f.Output(res, headers, rows)
+	}
+
+	return nil
+}
+
+// eliminatedBefore reports whether option i was eliminated in a round
+// before round r.
+func eliminatedBefore(rounds []ranking.Round, i, r int) bool {
+	for _, round := range rounds[:r] {
+		for _, e := range round.Eliminated {
+			if e == i {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// irvRoundsTable shows each option's votes per round, with the exhausted
+// ballots and the eliminated option below.
+func irvRoundsTable(results *api.PollResults, res ranking.Result) ([]string, [][]string) {
+	headers := []string{"Option"}
+	for r := range res.Rounds {
+		headers = append(headers, fmt.Sprintf("Round %d", r+1))
+	}
+
+	rows := make([][]string, 0, len(res.Order)+2)
+
+	for _, i := range res.Order {
+		row := []string{results.PollOptions[i].Value}
+
+		for r, round := range res.Rounds {
+			if eliminatedBefore(res.Rounds, i, r) {
+				row = append(row, "-")
+			} else {
+				row = append(row, strconv.Itoa(round.Votes[i]))
+			}
+		}
+
+		rows = append(rows, row)
+	}
+
+	exhausted := []string{"Exhausted"}
+	eliminated := []string{"Eliminated"}
+
+	for _, round := range res.Rounds {
+		exhausted = append(exhausted, strconv.Itoa(round.Exhausted))
+
+		var names []string
+		for _, e := range round.Eliminated {
+			names = append(names, results.PollOptions[e].Value)
+		}
+
+		eliminated = append(eliminated, strings.Join(names, ", "))
+	}
+
+	return headers, append(rows, exhausted, eliminated)
+}
+
+// schulzePathsTable shows the winner's strongest path to every other
+// option against the strongest path back.
+func schulzePathsTable(results *api.PollResults, res ranking.Result) ([]string, [][]string) {
+	headers := []string{"Against", "Strongest path", "Strength", "Reverse"}
+	rows := make([][]string, 0, len(res.Paths))
+
+	for _, p := range res.Paths {
+		names := make([]string, len(p.Via))
+		for k, i := range p.Via {
+			names[k] = results.PollOptions[i].Value
+		}
+
+		rows = append(rows, []string{
+			results.PollOptions[p.To].Value,
+			strings.Join(names, " > "),
+			strconv.Itoa(p.Strength),
+			strconv.Itoa(p.Reverse),
+		})
+	}
+
+	return headers, rows
+}
+
+// rankedPairsTable lists the pairwise majorities in locking order.
+func rankedPairsTable(results *api.PollResults, res ranking.Result) ([]string, [][]string) {
+	headers := []string{"Majority", "Votes", "Status"}
+	rows := make([][]string, 0, len(res.Pairs))
+
+	for _, p := range res.Pairs {
+		status := "locked"
+		if !p.Locked {
+			status = "skipped (cycle)"
+		}
+
+		rows = append(rows, []string{
+			results.PollOptions[p.Winner].Value + " > " + results.PollOptions[p.Loser].Value,
+			fmt.Sprintf("%d-%d", p.For, p.Against),
+			status,
+		})
+	}
+
+	return headers, rows
+}
@@ -0,0 +1,174 @@
+package cmd
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/dedene/strawpoll-cli/internal/api"
+	"github.com/dedene/strawpoll-cli/internal/output"
+	"github.com/dedene/strawpoll-cli/internal/ranking"
+)
+
+// pairwiseJSON is the structured output of ranking results --pairwise.
+type pairwiseJSON struct {
+	ID      string   `json:"id"`
+	Options []string `json:"options"`
+	// Matrix[i][j] is the number of voters ranking option i above option j.
+	Matrix          [][]int    `json:"matrix"`
+	CondorcetWinner string     `json:"condorcetWinner,omitempty"`
+	CondorcetLoser  string     `json:"condorcetLoser,omitempty"`
+	Cycles          [][]string `json:"cycles,omitempty"`
+}
+
+// outputPairwise renders the head-to-head matrix of ballots with its
+// Condorcet winner, loser and cycles.
+func outputPairwise(f *output.Formatter, results *api.PollResults, ballots []ranking.Ballot) error {
+	n := len(results.PollOptions)
+	d := ranking.Pairwise(ballots, n)
+	p := buildPairwiseJSON(results, d)
+
+	if f.UsesValue() {
+		return f.Output(p, nil, nil)
+	}
+
+	highlight := f.Mode == output.ModeTable && !f.Accessible
+	headers, rows := pairwiseTable(p, f.Colors, highlight)
+
+	if f.Mode == output.ModeTable && f.Accessible {
+		headers, rows = pairwiseSentenceTable(headers, rows)
+	}
+
+	if err := f.Output(p, headers, rows); err != nil {
+		return err
+	}
+
+	// The summary would break CSV and other machine-readable tables.
+	if f.Mode != output.ModeTable {
+		return nil
+	}
+
+	fmt.Fprintln(f.Writer)
+
+	return f.OutputSingle(p, pairwiseSummary(p))
+}
+
+func buildPairwiseJSON(results *api.PollResults, d [][]int) *pairwiseJSON {
+	name := func(i int) string { return results.PollOptions[i].Value }
+
+	p := &pairwiseJSON{ID: results.ID, Matrix: d}
+	for _, opt := range results.PollOptions {
+		p.Options = append(p.Options, opt.Value)
+	}
+
+	if w, ok := ranking.CondorcetWinner(d); ok {
+		p.CondorcetWinner = name(w)
+	}
+
+	if l, ok := ranking.CondorcetLoser(d); ok {
+		p.CondorcetLoser = name(l)
+	}
+
+	for _, cycle := range ranking.Cycles(d) {
+		names := make([]string, len(cycle))
+		for k, i := range cycle {
+			names[k] = name(i)
+		}
+
+		p.Cycles = append(p.Cycles, names)
+	}
+
+	return p
+}
+
+// pairwiseTable builds the matrix table: each cell counts the voters who
+// ranked the row option above the column option. With highlight, winning
+// cells are colored as successes and losing cells as errors.
+func pairwiseTable(p *pairwiseJSON, colors *output.Colors, highlight bool) ([]string, [][]string) {
+	headers := append([]string{"Option"}, p.Options...)
+	headers = append(headers, "Won-Lost-Tied")
+
+	rows := make([][]string, len(p.Options))
+
+	for i, opt := range p.Options {
+		row := []string{opt}
+		won, lost, tied := 0, 0, 0
+
+		for j := range p.Options {
+			if i == j {
+				row = append(row, "-")
+
+				continue
+			}
+
+			cell := strconv.Itoa(p.Matrix[i][j])
+
+			switch {
+			case p.Matrix[i][j] > p.Matrix[j][i]:
+				won++
+
+				if highlight {
+					cell = colors.Success(cell)
+				}
+			case p.Matrix[i][j] < p.Matrix[j][i]:
+				lost++
+
+				if highlight {
+					cell = colors.Error(cell)
+				}
+			default:
+				tied++
+			}
+
+			row = append(row, cell)
+		}
+
+		rows[i] = append(row, fmt.Sprintf("%d-%d-%d", won, lost, tied))
+	}
+
+	return headers, rows
+}
+
+// pairwiseSentenceTable rephrases the matrix so accessible sentences read
+// "Option A: preferred over B by 2 voters, ...".
+func pairwiseSentenceTable(headers []string, rows [][]string) ([]string, [][]string) {
+	for j := 1; j < len(headers)-1; j++ {
+		headers[j] = "preferred over " + headers[j]
+	}
+
+	for _, row := range rows {
+		for j := 1; j < len(row)-1; j++ {
+			switch row[j] {
+			case "-":
+			case "1":
+				row[j] = "by 1 voter"
+			default:
+				row[j] = "by " + row[j] + " voters"
+			}
+		}
+	}
+
+	return headers, rows
+}
+
+func pairwiseSummary(p *pairwiseJSON) [][2]string {
+	or := func(s string) string {
+		if s == "" {
+			return "none"
+		}
+
+		return s
+	}
+
+	pairs := [][2]string{
+		{"Condorcet winner", or(p.CondorcetWinner)},
+		{"Condorcet loser", or(p.CondorcetLoser)},
+	}
+
+	cycles := make([]string, len(p.Cycles))
+	for i, c := range p.Cycles {
+		cycles[i] = strings.Join(c, " > ")
+	}
+
+	return append(pairs, [2]string{"Cycles", or(strings.Join(cycles, "; "))})
+}
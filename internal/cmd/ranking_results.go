@@ -8,16 +8,35 @@ import (
 
 	"github.com/dedene/strawpoll-cli/internal/api"
 	"github.com/dedene/strawpoll-cli/internal/output"
+	"github.com/dedene/strawpoll-cli/internal/ranking"
 )
 
-// RankingResultsCmd displays ranking poll results with Borda count scoring.
+// RankingResultsCmd displays ranking poll results, scored with Borda count
+// or another ranked-ballot method.
 type RankingResultsCmd struct {
-	ID      string `arg:"" required:"" help:"Poll ID or URL"`
-	Verbose bool   `help:"Show per-option position breakdown" short:"v"`
+	ID       string `arg:"" required:"" help:"Poll ID or URL"`
+	Verbose  bool   `help:"Show per-option position breakdown" short:"v"`
+	Chart    bool   `help:"Show scores as a bar chart"`
+	Method   string `help:"Counting method: borda, irv, schulze, copeland, ranked-pairs, plurality" default:"borda" enum:"borda,irv,schulze,copeland,ranked-pairs,plurality"`
+	Pairwise bool   `help:"Show the head-to-head matrix: voters ranking each row option above each column option"`
+
+	WinnerFlags `embed:""`
+	WeightFlags `embed:""`
 }
 
-// Run fetches ranking results and displays Borda count scores.
+// Run fetches ranking results and displays scores for the chosen method,
+// with IRV rounds, Schulze paths or locked pairs to explain the outcome.
 func (c *RankingResultsCmd) Run(flags *RootFlags) error {
+	method, err := ranking.ParseMethod(c.Method)
+	if err != nil {
+		return &ExitError{Code: CodeUsage, Err: err}
+	}
+
+	table, err := c.loadWeights()
+	if err != nil {
+		return err
+	}
+
 	id := api.ParsePollID(c.ID)
 
 	client, err := newClientFromAuth()
@@ -26,28 +45,112 @@ func (c *RankingResultsCmd) Run(flags *RootFlags) error {
 	}
 	defer client.Close()
 
-	results, err := client.GetPollResults(context.Background(), id)
+	ctx := context.Background()
+
+	results, err := client.GetPollResults(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	ballots := rankingBallots(results)
+
+	var (
+		weights []float64
+		report  *weightsJSON
+	)
+
+	if table != nil {
+		if weights, report, err = table.apply(results, os.Stderr); err != nil {
+			return err
+		}
+	}
+
+	f := newFormatter(flags)
+
+	if c.Pairwise {
+		// Head-to-head counts are voters, so weighted ballots are repeated.
+		if weights != nil {
+			counts, err := wholeWeights(weights, "--pairwise")
+			if err != nil {
+				return err
+			}
+
+			ballots = ranking.WeightBallots(ballots, counts)
+		}
+
+		return outputPairwise(f, results, ballots)
+	}
+
+	n := len(results.PollOptions)
+
+	res, err := countRanking(method, ballots, weights, n)
+	if err != nil {
+		return err
+	}
+
+	if report != nil {
+		raw := ranking.Count(method, ballots, n)
+		for i, opt := range results.PollOptions {
+			report.Options = append(report.Options, weightedOptionJSON{
+				Value: opt.Value, Raw: raw.Scores[i], Weighted: res.Scores[i],
+			})
+		}
+	}
+
+	// Plurality takes any weight, so this cannot fail.
+	prefs, _ := ranking.CountWeighted(ranking.Plurality, ballots, weights, n)
+
+	// number_of_winners lives on the poll, not the results.
+	var poll *api.Poll
+	if c.Winners == 0 {
+		if poll, err = client.GetPoll(ctx, id); err != nil {
+			return err
+		}
+	}
+
+	winners, err := c.decide(poll, results.PollOptions, res.Scores, prefs.Scores)
 	if err != nil {
 		return err
 	}
 
-	f := output.NewFormatter(os.Stdout, flags.JSON, flags.Plain, flags.NoColor)
+	// Structured modes and templates: output enriched struct with computed scores
+	if f.UsesValue() {
+		enriched := buildRankingJSON(results, ballots, weights, res)
+		enriched.winnersJSON = winners
+		enriched.Weights = report
+
+		if err := f.Output(enriched, nil, nil); err != nil {
+			return err
+		}
+
+		return c.check(winners)
+	}
+
+	// Summary sorted by score descending
+	if showChart(c.Chart, f) {
+		if err := f.Chart(rankingChart(results, res, report)); err != nil {
+			return err
+		}
+	} else {
+		headers, rows, index, rankCol := rankingSummary(results, res, report)
+		headers, rows = withWinners(headers, rows, index, winners)
+
+		if f.Accessible {
+			headers, rows = withRank(headers, rows, rankCol)
+		}
 
-	// JSON mode: output enriched struct with computed scores
-	if flags.JSON {
-		enriched := buildRankingJSON(results)
-		return f.Output(enriched, nil, nil)
+		if err := f.Output(results, headers, rows); err != nil {
+			return err
+		}
 	}
 
-	// Summary table sorted by score descending
-	headers, rows := rankingScoreTable(results)
-	if err := f.Output(results, headers, rows); err != nil {
+	if err := explainRanking(f, results, res); err != nil {
 		return err
 	}
 
 	// Position breakdown if --verbose
 	if c.Verbose && len(results.PollOptions) > 0 {
-		fmt.Fprintln(os.Stdout)
+		fmt.Fprintln(f.Writer)
 
 		bHeaders, bRows := rankingBreakdownTable(results)
 		if err := f.Output(results, bHeaders, bRows); err != nil {
@@ -55,7 +158,52 @@ func (c *RankingResultsCmd) Run(flags *RootFlags) error {
 		}
 	}
 
-	return nil
+	return c.check(winners)
+}
+
+// rankingSummary builds the score table: raw Borda scores with their
+// percentages, or the method's scores in its finishing order. With a
+// weights report the rows follow the weighted order and the score column
+// shows the raw score next to a Weighted column. rankCol is the column
+// that ranks the rows.
+func rankingSummary(results *api.PollResults, res ranking.Result, report *weightsJSON) ([]string, [][]string, []int, int) {
+	if res.Method == ranking.Borda && report == nil {
+		headers, rows, index := rankingScoreTable(results)
+
+		return headers, rows, index, 1
+	}
+
+	headers, rows, index := methodScoreTable(results, res)
+	if report == nil {
+		return headers, rows, index, 1
+	}
+
+	for r, i := range index {
+		rows[r][1] = formatWeight(report.Options[i].Raw)
+	}
+
+	rankCol := len(headers)
+	headers, rows = withWeighted(headers, rows, index, report, false)
+
+	return headers, rows, index, rankCol
+}
+
+// rankingChart builds the chart bars: raw Borda scores, or the method's
+// scores, weighted when a weights report is given with the raw score
+// alongside.
+func rankingChart(results *api.PollResults, res ranking.Result, report *weightsJSON) []output.Bar {
+	if res.Method == ranking.Borda && report == nil {
+		return rankingBars(results)
+	}
+
+	bars := methodBars(results, res)
+	if report != nil {
+		for b, i := range res.Order {
+			bars[b].Display = fmt.Sprintf("%s  (%s raw)", formatWeight(res.Scores[i]), formatWeight(report.Options[i].Raw))
+		}
+	}
+
+	return bars
 }
 
 // bordaScores computes Borda count scores for each option.
@@ -96,43 +244,84 @@ func positionBreakdown(results *api.PollResults) [][]int {
 	return breakdown
 }
 
-// rankingScoreTable builds the summary table sorted by score descending.
-func rankingScoreTable(results *api.PollResults) ([]string, [][]string) {
+// rankingScoreTable builds the summary table sorted by score descending,
+// ties in poll order. index maps each row to its option.
+func rankingScoreTable(results *api.PollResults) ([]string, [][]string, []int) {
 	n := len(results.PollOptions)
 	scores := bordaScores(results)
 	maxScore := n * len(results.PollParticipants)
 
-	// Build sortable entries
-	type entry struct {
-		name  string
-		score int
-	}
-
-	entries := make([]entry, n)
-	for i, opt := range results.PollOptions {
-		entries[i] = entry{name: opt.Value, score: scores[i]}
-	}
-
-	sort.Slice(entries, func(i, j int) bool {
-		return entries[i].score > entries[j].score
+	index := optionIndex(n)
+	sort.SliceStable(index, func(i, j int) bool {
+		return scores[index[i]] > scores[index[j]]
 	})
 
 	headers := []string{"Option", "Score", "Percentage"}
 	rows := make([][]string, n)
 
-	for i, e := range entries {
+	for r, i := range index {
 		pct := 0.0
 		if maxScore > 0 {
-			pct = float64(e.score) / float64(maxScore) * 100
+			pct = float64(scores[i]) / float64(maxScore) * 100
 		}
 
-		rows[i] = []string{
-			e.name,
-			fmt.Sprintf("%d", e.score),
+		rows[r] = []string{
+			results.PollOptions[i].Value,
+			fmt.Sprintf("%d", scores[i]),
 			fmt.Sprintf("%.1f%%", pct),
 		}
 	}
 
+	return headers, rows, index
+}
+
+// rankingBars builds one chart bar per option, sorted by Borda score descending.
+func rankingBars(results *api.PollResults) []output.Bar {
+	scores := bordaScores(results)
+	maxScore := len(results.PollOptions) * len(results.PollParticipants)
+
+	bars := make([]output.Bar, len(results.PollOptions))
+	for i, opt := range results.PollOptions {
+		bars[i] = output.Bar{
+			Label:   opt.Value,
+			Value:   float64(scores[i]),
+			Display: fmt.Sprintf("%d  %5.1f%%", scores[i], percent(scores[i], maxScore)),
+		}
+	}
+
+	sort.SliceStable(bars, func(i, j int) bool { return bars[i].Value > bars[j].Value })
+
+	return bars
+}
+
+// rankingParticipantsTable shows the position each participant gave each option.
+func rankingParticipantsTable(results *api.PollResults) ([]string, [][]string) {
+	n := len(results.PollOptions)
+
+	headers := make([]string, 0, 1+n)
+	headers = append(headers, "Name")
+
+	for _, opt := range results.PollOptions {
+		headers = append(headers, opt.Value)
+	}
+
+	rows := make([][]string, 0, len(results.PollParticipants))
+
+	for _, p := range results.PollParticipants {
+		row := make([]string, 0, 1+n)
+		row = append(row, participantName(p))
+
+		for i := range results.PollOptions {
+			if i < len(p.PollVotes) && p.PollVotes[i] != nil {
+				row = append(row, fmt.Sprintf("#%d", *p.PollVotes[i]+1))
+			} else {
+				row = append(row, "-")
+			}
+		}
+
+		rows = append(rows, row)
+	}
+
 	return headers, rows
 }
 
@@ -170,7 +359,13 @@ type rankingJSONResult struct {
 	ID               string              `json:"id"`
 	VoteCount        int                 `json:"voteCount"`
 	ParticipantCount int                 `json:"participantCount"`
+	Method           string              `json:"method"`
 	Options          []rankingJSONOption `json:"options"`
+	Rounds           []rankingJSONRound  `json:"rounds,omitempty"`
+	Paths            []rankingJSONPath   `json:"paths,omitempty"`
+	Pairs            []rankingJSONPair   `json:"pairs,omitempty"`
+	Weights          *weightsJSON        `json:"weights,omitempty"`
+	*winnersJSON
 }
 
 type rankingJSONOption struct {
@@ -179,14 +374,58 @@ type rankingJSONOption struct {
 	Score      int     `json:"score"`
 	Percentage float64 `json:"percentage"`
 	Positions  []int   `json:"positions"`
+	// Rank is the place under the chosen method, 1 for the winner.
+	Rank int `json:"rank"`
+	// Scores holds the option's score under every method.
+	Scores map[string]float64 `json:"scores"`
+}
+
+type rankingJSONRound struct {
+	Round      int            `json:"round"`
+	Votes      map[string]int `json:"votes"`
+	Eliminated []string       `json:"eliminated,omitempty"`
+	Exhausted  int            `json:"exhausted"`
+}
+
+type rankingJSONPath struct {
+	From     string   `json:"from"`
+	To       string   `json:"to"`
+	Path     []string `json:"path"`
+	Strength int      `json:"strength"`
+	Reverse  int      `json:"reverse"`
 }
 
-func buildRankingJSON(results *api.PollResults) *rankingJSONResult {
+type rankingJSONPair struct {
+	Winner  string `json:"winner"`
+	Loser   string `json:"loser"`
+	For     int    `json:"for"`
+	Against int    `json:"against"`
+	Locked  bool   `json:"locked"`
+}
+
+// buildRankingJSON reports the raw Borda scores and positions of results,
+// with the score under every method counted from ballots, weighted by
+// weights when set. Methods that cannot take fractional weights are left
+// out of the scores.
+func buildRankingJSON(results *api.PollResults, ballots []ranking.Ballot, weights []float64, res ranking.Result) *rankingJSONResult {
 	n := len(results.PollOptions)
 	scores := bordaScores(results)
 	breakdown := positionBreakdown(results)
 	maxScore := n * len(results.PollParticipants)
 
+	all := make(map[ranking.Method]ranking.Result, len(ranking.Methods))
+
+	for _, m := range ranking.Methods {
+		if r, err := countRanking(m, ballots, weights, n); err == nil {
+			all[m] = r
+		}
+	}
+
+	rank := make([]int, n)
+	for place, i := range res.Order {
+		rank[i] = place + 1
+	}
+
 	opts := make([]rankingJSONOption, n)
 	for i, opt := range results.PollOptions {
 		pct := 0.0
@@ -194,19 +433,67 @@ func buildRankingJSON(results *api.PollResults) *rankingJSONResult {
 			pct = float64(scores[i]) / float64(maxScore) * 100
 		}
 
+		methodScores := make(map[string]float64, len(all))
+		for m, r := range all {
+			methodScores[string(m)] = r.Scores[i]
+		}
+
 		opts[i] = rankingJSONOption{
 			ID:         opt.ID,
 			Value:      opt.Value,
 			Score:      scores[i],
 			Percentage: pct,
 			Positions:  breakdown[i],
+			Rank:       rank[i],
+			Scores:     methodScores,
 		}
 	}
 
-	return &rankingJSONResult{
+	out := &rankingJSONResult{
 		ID:               results.ID,
 		VoteCount:        results.VoteCount,
 		ParticipantCount: results.ParticipantCount,
+		Method:           string(res.Method),
 		Options:          opts,
 	}
+
+	name := func(i int) string { return results.PollOptions[i].Value }
+	names := func(idx []int) []string {
+		out := make([]string, len(idx))
+		for k, i := range idx {
+			out[k] = name(i)
+		}
+
+		return out
+	}
+
+	for r, round := range res.Rounds {
+		votes := make(map[string]int)
+		for i, v := range round.Votes {
+			if !eliminatedBefore(res.Rounds, i, r) {
+				votes[name(i)] = v
+			}
+		}
+
+		out.Rounds = append(out.Rounds, rankingJSONRound{
+			Round:      r + 1,
+			Votes:      votes,
+			Eliminated: names(round.Eliminated),
+			Exhausted:  round.Exhausted,
+		})
+	}
+
+	for _, p := range res.Paths {
+		out.Paths = append(out.Paths, rankingJSONPath{
+			From: name(p.From), To: name(p.To), Path: names(p.Via), Strength: p.Strength, Reverse: p.Reverse,
+		})
+	}
+
+	for _, p := range res.Pairs {
+		out.Pairs = append(out.Pairs, rankingJSONPair{
+			Winner: name(p.Winner), Loser: name(p.Loser), For: p.For, Against: p.Against, Locked: p.Locked,
+		})
+	}
+
+	return out
 }
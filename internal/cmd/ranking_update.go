@@ -3,10 +3,9 @@ package cmd
 import (
 	"context"
 	"fmt"
-	"os"
+	"time"
 
 	"github.com/dedene/strawpoll-cli/internal/api"
-	"github.com/dedene/strawpoll-cli/internal/output"
 )
 
 // RankingUpdateCmd updates an existing ranking poll.
@@ -25,7 +24,7 @@ func (c *RankingUpdateCmd) Run(flags *RootFlags) error {
 
 	id := api.ParsePollID(c.ID)
 
-	client, err := newClientFromAuth()
+	client, err := newWriteClient(flags)
 	if err != nil {
 		return err
 	}
@@ -37,11 +36,11 @@ func (c *RankingUpdateCmd) Run(flags *RootFlags) error {
 		req.Title = c.Title
 	}
 
-	// If removing options, fetch existing poll first
-	if len(c.RemoveOption) > 0 {
-		poll, err := client.GetPoll(context.Background(), id)
+	// Changing options replaces the full list, so start from the existing poll.
+	if len(c.RemoveOption) > 0 || len(c.AddOption) > 0 {
+		poll, err := fetchForUpdate(context.Background(), client, flags, id)
 		if err != nil {
-			return fmt.Errorf("fetch poll for option removal: %w", err)
+			return err
 		}
 
 		removeSet := make(map[int]bool, len(c.RemoveOption))
@@ -74,6 +73,10 @@ func (c *RankingUpdateCmd) Run(flags *RootFlags) error {
 		})
 	}
 
+	if err := api.ValidateUpdatePollRequest(req, time.Now()); err != nil {
+		return &ExitError{Code: CodeUsage, Err: err}
+	}
+
 	poll, err := client.UpdatePoll(context.Background(), id, req)
 	if err != nil {
 		return err
@@ -81,13 +84,13 @@ func (c *RankingUpdateCmd) Run(flags *RootFlags) error {
 
 	pollURL := pollBaseURL + poll.ID
 
-	f := output.NewFormatter(os.Stdout, flags.JSON, flags.Plain, flags.NoColor)
-	return f.OutputSingle(poll, [][2]string{
+	f := newFormatter(flags)
+	return f.OutputSingle(poll, append([][2]string{
 		{"ID", poll.ID},
 		{"Title", poll.Title},
 		{"Type", poll.Type},
 		{"URL", pollURL},
 		{"Options", fmt.Sprintf("%d", len(poll.PollOptions))},
 		{"Votes", voteCount(poll)},
-	})
+	}, pollTimes(poll)...))
 }
@@ -4,17 +4,152 @@ import (
 	"errors"
 	"fmt"
 	"os"
+	"strings"
+	"text/template"
 
 	"github.com/alecthomas/kong"
+
+	"github.com/dedene/strawpoll-cli/internal/api"
+	"github.com/dedene/strawpoll-cli/internal/config"
+	"github.com/dedene/strawpoll-cli/internal/output"
+	"github.com/dedene/strawpoll-cli/internal/theme"
+	"github.com/dedene/strawpoll-cli/internal/tui"
 )
 
 // RootFlags are global flags available to all commands.
 type RootFlags struct {
-	JSON    bool `help:"Output JSON to stdout" short:"j"`
-	Plain   bool `help:"Output plain TSV (for scripting)"`
-	NoColor bool `help:"Disable colors" env:"NO_COLOR"`
-	Copy    bool `help:"Copy poll URL to clipboard"`
-	Open    bool `help:"Open poll URL in browser"`
+	JSON        bool     `help:"Output JSON to stdout (same as --output json)" short:"j"`
+	Plain       bool     `help:"Output plain TSV for scripting (same as --output plain)"`
+	Output      string   `help:"Output format: table, json, plain, csv, yaml, ndjson, markdown" short:"o" placeholder:"FORMAT"`
+	Template    string   `help:"Format output with a Go template, e.g. '{{.ID}} {{.Title}}' (helpers: url, time, pct, join, json)" placeholder:"TEMPLATE"`
+	Fields      []string `help:"Comma-separated columns or keys to output, e.g. id,title,votes" placeholder:"FIELDS"`
+	Wide        bool     `help:"Do not fit tables to the terminal width"`
+	MaxColWidth int      `help:"Truncate or wrap table cells wider than N columns" name:"max-col-width" placeholder:"N"`
+	Wrap        bool     `help:"Wrap long table cells instead of truncating them"`
+	NoPager     bool     `help:"Do not pipe long table output through $STRAWPOLL_PAGER or $PAGER" name:"no-pager"`
+	NoColor     bool     `help:"Disable colors" env:"NO_COLOR"`
+	Theme       string   `help:"Color theme: default, high-contrast, solarized, colorblind-safe or a custom theme from config" env:"STRAWPOLL_THEME" placeholder:"NAME"`
+	Accessible  bool     `help:"Describe tables as sentences and use accessible prompts, for screen readers" env:"STRAWPOLL_ACCESSIBLE"`
+	TimeFormat  string   `help:"Timestamps: absolute, relative or iso" name:"time-format" env:"STRAWPOLL_TIME_FORMAT" placeholder:"STYLE"`
+	Copy        bool     `help:"Copy poll URL to clipboard"`
+	Open        bool     `help:"Open poll URL in browser"`
+	DryRun      bool     `help:"Print mutating API requests instead of sending them (reads, such as fetching a poll before changing its options, are still sent)" name:"dry-run"`
+
+	mode     output.Mode
+	template *template.Template
+	pager    *output.Pager
+}
+
+// AfterApply resolves the output mode, color theme, accessible mode and
+// time format and parses --template. An explicit --output wins over --json and
+// --plain, which are kept in sync so either can be checked.
+func (f *RootFlags) AfterApply() error {
+	// A broken config file is ignored here; commands that read it report the error.
+	cfg, _ := config.ReadConfig()
+
+	if err := f.applyTheme(cfg); err != nil {
+		return &ExitError{Code: CodeUsage, Err: err}
+	}
+
+	if cfg.Accessible != nil && *cfg.Accessible {
+		f.Accessible = true
+	}
+
+	tui.SetAccessible(f.Accessible)
+
+	if err := f.applyTimeFormat(cfg); err != nil {
+		return &ExitError{Code: CodeUsage, Err: err}
+	}
+
+	if f.Template != "" {
+		tmpl, err := output.ParseTemplate(f.Template, template.FuncMap{
+			"url": func(id string) string { return pollBaseURL + api.ParsePollID(id) },
+		})
+		if err != nil {
+			return &ExitError{Code: CodeUsage, Err: err}
+		}
+
+		f.template = tmpl
+	}
+
+	mode := output.ModeFromFlags(f.JSON, f.Plain)
+
+	if f.Output != "" {
+		m, err := output.ParseMode(f.Output)
+		if err != nil {
+			return &ExitError{Code: CodeUsage, Err: err}
+		}
+
+		mode = m
+	}
+
+	f.mode = mode
+	f.JSON = mode == output.ModeJSON
+	f.Plain = mode == output.ModePlain
+
+	return nil
+}
+
+// applyTheme activates --theme, or the theme config key, for tables and
+// the TUI.
+func (f *RootFlags) applyTheme(cfg config.File) error {
+	name := f.Theme
+	if name == "" {
+		name = cfg.Theme
+	}
+
+	t, err := theme.Lookup(name, cfg.Themes)
+	if err != nil {
+		return err
+	}
+
+	theme.Set(t)
+
+	if !f.NoColor {
+		tui.ApplyTheme(t)
+	}
+
+	return nil
+}
+
+// applyTimeFormat sets the time style from --time-format or the
+// time_format key, and the clock from the clock key or the locale.
+func (f *RootFlags) applyTimeFormat(cfg config.File) error {
+	name := f.TimeFormat
+	if name == "" {
+		name = cfg.TimeFormat
+	}
+
+	style, err := output.ParseTimeStyle(name)
+	if err != nil {
+		return err
+	}
+
+	hour12, err := output.ParseClock(cfg.Clock)
+	if err != nil {
+		return err
+	}
+
+	output.SetTimeFormat(output.TimeFormat{Style: style, Hour12: hour12})
+
+	return nil
+}
+
+// AfterRun flushes table output held back for the pager.
+func (f *RootFlags) AfterRun() error {
+	if f.pager == nil {
+		return nil
+	}
+
+	err := f.pager.Close()
+	f.pager = nil
+
+	return err
+}
+
+// OutputMode returns the resolved output mode.
+func (f *RootFlags) OutputMode() output.Mode {
+	return f.mode
 }
 
 // CLI is the top-level Kong CLI struct.
@@ -25,6 +160,8 @@ type CLI struct {
 	VersionCmd VersionCmd       `cmd:"" name:"version" help:"Show version information"`
 	Auth       AuthCmd          `cmd:"" help:"Manage API key"`
 	Config     ConfigCmd        `cmd:"" help:"Manage configuration"`
+	Alias      AliasCmd         `cmd:"" help:"Manage command aliases"`
+	Plugin     PluginCmd        `cmd:"" help:"Manage external plugins"`
 	Poll       PollCmd          `cmd:"" help:"Poll commands"`
 	Meeting    MeetingCmd       `cmd:"" help:"Meeting poll commands"`
 	Ranking    RankingCmd       `cmd:"" help:"Ranking poll commands"`
@@ -62,6 +199,24 @@ func Execute(args []string) (err error) {
 		args = []string{"--help"}
 	}
 
+	args, err = resolveAliases(args, parser)
+	if err != nil {
+		err = &ExitError{Code: CodeUsage, Err: err}
+		_, _ = fmt.Fprintln(os.Stderr, err)
+
+		return err
+	}
+
+	if handled, pluginErr := runPlugin(args, commandNames(parser)); handled {
+		// Plugins report their own failures; only surface errors launching them.
+		var ee *ExitError
+		if pluginErr != nil && !errors.As(pluginErr, &ee) {
+			_, _ = fmt.Fprintln(os.Stderr, pluginErr)
+		}
+
+		return pluginErr
+	}
+
 	kctx, err := parser.Parse(args)
 	if err != nil {
 		parsedErr := wrapParseError(err)
@@ -71,6 +226,10 @@ func Execute(args []string) (err error) {
 	}
 
 	err = kctx.Run()
+	if errors.Is(err, api.ErrDryRun) {
+		return nil
+	}
+
 	if err != nil {
 		_, _ = fmt.Fprintln(os.Stderr, err)
 
@@ -80,6 +239,47 @@ func Execute(args []string) (err error) {
 	return nil
 }
 
+// resolveAliases expands a user-defined alias naming the command, if any.
+// A broken config file is ignored here; commands that read it report the error.
+func resolveAliases(args []string, parser *kong.Kong) ([]string, error) {
+	cfg, err := config.ReadConfig()
+	if err != nil || len(cfg.Aliases) == 0 {
+		return args, nil //nolint:nilerr // config errors surface in commands
+	}
+
+	lead, rest := splitLeadingFlags(args)
+
+	expanded, err := expandAlias(rest, cfg.Aliases, commandNames(parser))
+	if err != nil {
+		return nil, err
+	}
+
+	return append(lead, expanded...), nil
+}
+
+// valueFlags are the global flags that take a separate value argument.
+var valueFlags = map[string]bool{
+	"-o": true, "--output": true, "--template": true, "--fields": true, "--max-col-width": true,
+	"--theme": true, "--time-format": true,
+}
+
+// splitLeadingFlags separates global flags given before the command name
+// from the command and its arguments.
+func splitLeadingFlags(args []string) ([]string, []string) {
+	for i := 0; i < len(args); i++ {
+		a := args[i]
+		if !strings.HasPrefix(a, "-") {
+			return args[:i:i], args[i:]
+		}
+
+		if valueFlags[a] {
+			i++
+		}
+	}
+
+	return args, nil
+}
+
 func wrapParseError(err error) error {
 	if err == nil {
 		return nil
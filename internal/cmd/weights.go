@@ -0,0 +1,238 @@
+package cmd
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/dedene/strawpoll-cli/internal/api"
+	"github.com/dedene/strawpoll-cli/internal/ranking"
+)
+
+// WeightFlags is the --weights flag shared by the results commands.
+type WeightFlags struct {
+	Weights string `help:"CSV file of name,weight rows: each participant's votes count weight times (participants not listed count once); ranking methods other than borda and plurality need whole-number weights" type:"path"`
+}
+
+// weightTable holds the weights read from a --weights file, by normalized
+// participant name.
+type weightTable struct {
+	file   string
+	byName map[string]float64
+	// names keeps the spelling used in the file, in file order.
+	names []string
+}
+
+// weightsJSON reports how a weight file was applied.
+type weightsJSON struct {
+	File        string  `json:"file"`
+	TotalWeight float64 `json:"totalWeight"`
+	// Unweighted lists participants missing from the file, counted once.
+	Unweighted []string `json:"unweighted,omitempty"`
+	// Unmatched lists names in the file that match no participant.
+	Unmatched []string             `json:"unmatched,omitempty"`
+	Options   []weightedOptionJSON `json:"options"`
+}
+
+// weightedOptionJSON is an option's weighted score alongside the raw one.
+type weightedOptionJSON struct {
+	Value      string  `json:"value"`
+	Raw        float64 `json:"raw"`
+	Weighted   float64 `json:"weighted"`
+	Percentage float64 `json:"percentage,omitempty"`
+}
+
+// weightKey matches participant names case-insensitively.
+func weightKey(name string) string {
+	return strings.ToLower(strings.TrimSpace(name))
+}
+
+// loadWeights reads --weights, or returns nil when it is not set. A first
+// row whose weight is not a number is taken as a header.
+func (w *WeightFlags) loadWeights() (*weightTable, error) {
+	if w.Weights == "" {
+		return nil, nil
+	}
+
+	file, err := os.Open(w.Weights)
+	if err != nil {
+		return nil, &ExitError{Code: CodeUsage, Err: fmt.Errorf("read weights: %w", err)}
+	}
+	defer file.Close()
+
+	t, err := parseWeights(file, filepath.Base(w.Weights))
+	if err != nil {
+		return nil, &ExitError{Code: CodeUsage, Err: err}
+	}
+
+	return t, nil
+}
+
+func parseWeights(r io.Reader, name string) (*weightTable, error) {
+	cr := csv.NewReader(r)
+	cr.FieldsPerRecord = -1
+	cr.TrimLeadingSpace = true
+	cr.Comment = '#'
+
+	t := &weightTable{file: name, byName: make(map[string]float64)}
+
+	for row := 0; ; row++ {
+		rec, err := cr.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", name, err)
+		}
+
+		line, _ := cr.FieldPos(0)
+
+		if len(rec) != 2 {
+			return nil, fmt.Errorf("%s line %d: want name,weight, got %d fields", name, line, len(rec))
+		}
+
+		weight, err := strconv.ParseFloat(strings.TrimSpace(rec[1]), 64)
+		if err != nil && row == 0 {
+			continue
+		}
+
+		if err != nil || weight < 0 || math.IsInf(weight, 0) || math.IsNaN(weight) {
+			return nil, fmt.Errorf("%s line %d: weight %q is not a non-negative number", name, line, rec[1])
+		}
+
+		key := weightKey(rec[0])
+		if key == "" {
+			return nil, fmt.Errorf("%s line %d: missing name", name, line)
+		}
+
+		if _, dup := t.byName[key]; dup {
+			return nil, fmt.Errorf("%s line %d: %q is listed twice", name, line, strings.TrimSpace(rec[0]))
+		}
+
+		t.byName[key] = weight
+		t.names = append(t.names, strings.TrimSpace(rec[0]))
+	}
+
+	return t, nil
+}
+
+// apply returns each participant's weight, in results order, and reports
+// participants missing from the file and names matching no participant,
+// with a warning on warn for each.
+func (t *weightTable) apply(results *api.PollResults, warn io.Writer) ([]float64, *weightsJSON, error) {
+	if len(results.PollParticipants) == 0 && results.ParticipantCount > 0 {
+		return nil, nil, &ExitError{Code: CodeUsage, Err: errors.New("--weights needs the participant list, which is hidden for this poll")}
+	}
+
+	weights := make([]float64, len(results.PollParticipants))
+	report := &weightsJSON{File: t.file}
+	matched := make(map[string]bool)
+
+	for i, p := range results.PollParticipants {
+		key := weightKey(p.Name)
+
+		w, ok := t.byName[key]
+		if !ok {
+			w = 1
+			report.Unweighted = append(report.Unweighted, participantName(p))
+			fmt.Fprintf(warn, "warning: %s has no weight in %s; counting their votes once\n", participantName(p), t.file)
+		}
+
+		matched[key] = true
+		weights[i] = w
+		report.TotalWeight += w
+	}
+
+	for _, name := range t.names {
+		if !matched[weightKey(name)] {
+			report.Unmatched = append(report.Unmatched, name)
+			fmt.Fprintf(warn, "warning: %s in %s matches no participant\n", name, t.file)
+		}
+	}
+
+	return weights, report, nil
+}
+
+// wholeWeights converts weights for counts that repeat ballots, which need
+// whole numbers; what names the count in the error.
+func wholeWeights(weights []float64, what string) ([]int, error) {
+	out := make([]int, len(weights))
+
+	for i, w := range weights {
+		if w != math.Trunc(w) {
+			return nil, &ExitError{Code: CodeUsage, Err: fmt.Errorf("%s needs whole-number weights, got %s", what, formatWeight(w))}
+		}
+
+		out[i] = int(w)
+	}
+
+	return out, nil
+}
+
+// countRanking counts ballots with method, ballot i weighted by
+// weights[i] when weights is set. Borda and plurality add up fractional
+// weights; the other methods repeat weighted ballots, so their weights
+// must be whole numbers.
+func countRanking(method ranking.Method, ballots []ranking.Ballot, weights []float64, n int) (ranking.Result, error) {
+	if weights == nil {
+		return ranking.Count(method, ballots, n), nil
+	}
+
+	if res, ok := ranking.CountWeighted(method, ballots, weights, n); ok {
+		return res, nil
+	}
+
+	counts, err := wholeWeights(weights, string(method))
+	if err != nil {
+		return ranking.Result{}, err
+	}
+
+	return ranking.Count(method, ranking.WeightBallots(ballots, counts), n), nil
+}
+
+// weightedCounts sums the weights of the participants choosing each option.
+func weightedCounts(results *api.PollResults, weights []float64) []float64 {
+	counts := make([]float64, len(results.PollOptions))
+
+	for p, part := range results.PollParticipants {
+		for i := range voteSet(part.PollVotes) {
+			if i >= 0 && i < len(counts) {
+				counts[i] += weights[p]
+			}
+		}
+	}
+
+	return counts
+}
+
+// formatWeight formats a weight or weighted score to at most two decimals.
+func formatWeight(v float64) string {
+	return formatScore(math.Round(v*100) / 100)
+}
+
+// withWeighted appends the weighted score of each row's option, and with
+// pct its percentage. index maps each row to its option.
+func withWeighted(headers []string, rows [][]string, index []int, report *weightsJSON, pct bool) ([]string, [][]string) {
+	headers = append(headers, "Weighted")
+	if pct {
+		headers = append(headers, "Weighted %")
+	}
+
+	for r, i := range index {
+		opt := report.Options[i]
+
+		rows[r] = append(rows[r], formatWeight(opt.Weighted))
+		if pct {
+			rows[r] = append(rows[r], fmt.Sprintf("%.1f%%", opt.Percentage))
+		}
+	}
+
+	return headers, rows
+}
@@ -0,0 +1,178 @@
+package cmd
+
+import (
+	"bytes"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/dedene/strawpoll-cli/internal/api"
+	"github.com/dedene/strawpoll-cli/internal/output"
+	"github.com/dedene/strawpoll-cli/internal/ranking"
+)
+
+func TestParseWeights(t *testing.T) {
+	in := "name,weight\n# steering committee\nAlice, 3\n bob ,0.5\nCarol,0\n"
+
+	got, err := parseWeights(strings.NewReader(in), "weights.csv")
+	if err != nil {
+		t.Fatalf("parseWeights error: %v", err)
+	}
+
+	want := map[string]float64{"alice": 3, "bob": 0.5, "carol": 0}
+	if !reflect.DeepEqual(got.byName, want) {
+		t.Errorf("parseWeights = %v, want %v", got.byName, want)
+	}
+}
+
+func TestParseWeights_Errors(t *testing.T) {
+	tests := []string{
+		"Alice,3\nBob,heavy\n",
+		"Alice,-1\n",
+		"Alice,1\nalice,2\n",
+		"Alice,1,extra\n",
+		",2\n",
+	}
+
+	for _, in := range tests {
+		if _, err := parseWeights(strings.NewReader(in), "weights.csv"); err == nil {
+			t.Errorf("parseWeights(%q): want error", in)
+		}
+	}
+}
+
+func TestWeightTable_Apply(t *testing.T) {
+	vote := func(v int) *int { return &v }
+	results := &api.PollResults{
+		PollOptions: []*api.PollOption{{Value: "Pizza"}, {Value: "Sushi"}},
+		PollParticipants: []*api.PollParticipant{
+			{Name: "ALICE", PollVotes: []*int{vote(0)}},
+			{Name: "Dave", PollVotes: []*int{vote(0), vote(1)}},
+		},
+	}
+
+	table, err := parseWeights(strings.NewReader("Alice,3\nBob,2\n"), "weights.csv")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var warn bytes.Buffer
+
+	weights, report, err := table.apply(results, &warn)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !reflect.DeepEqual(weights, []float64{3, 1}) {
+		t.Errorf("weights = %v, want [3 1]", weights)
+	}
+
+	if !reflect.DeepEqual(report.Unweighted, []string{"Dave"}) || !reflect.DeepEqual(report.Unmatched, []string{"Bob"}) {
+		t.Errorf("unweighted %v, unmatched %v", report.Unweighted, report.Unmatched)
+	}
+
+	if n := strings.Count(warn.String(), "warning:"); n != 2 {
+		t.Errorf("got %d warnings, want 2:\n%s", n, warn.String())
+	}
+
+	if got := weightedCounts(results, weights); !reflect.DeepEqual(got, []float64{4, 1}) {
+		t.Errorf("weightedCounts = %v, want [4 1]", got)
+	}
+}
+
+// weightedBorda counts a ranking poll where Alice ranks Pizza > Sushi >
+// Tacos with weight 0.5 and Bob ranks Sushi > Tacos > Pizza with weight 2:
+// raw Borda puts Pizza second, weighted Borda last.
+func weightedBorda(t *testing.T) (*api.PollResults, ranking.Result, *weightsJSON) {
+	t.Helper()
+
+	pos := func(v ...int) []*int {
+		out := make([]*int, len(v))
+		for i := range v {
+			out[i] = &v[i]
+		}
+
+		return out
+	}
+	results := &api.PollResults{
+		PollOptions: []*api.PollOption{{Value: "Pizza"}, {Value: "Sushi"}, {Value: "Tacos"}},
+		PollParticipants: []*api.PollParticipant{
+			{Name: "Alice", PollVotes: pos(0, 1, 2)},
+			{Name: "Bob", PollVotes: pos(2, 0, 1)},
+		},
+	}
+
+	table, err := parseWeights(strings.NewReader("Alice,0.5\nBob,2\n"), "weights.csv")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	weights, report, err := table.apply(results, &bytes.Buffer{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ballots := rankingBallots(results)
+
+	res, err := countRanking(ranking.Borda, ballots, weights, 3)
+	if err != nil {
+		t.Fatalf("countRanking(borda) error: %v", err)
+	}
+
+	raw := ranking.Count(ranking.Borda, ballots, 3)
+	for i, opt := range results.PollOptions {
+		report.Options = append(report.Options, weightedOptionJSON{Value: opt.Value, Raw: raw.Scores[i], Weighted: res.Scores[i]})
+	}
+
+	return results, res, report
+}
+
+func TestRankingSummary_WeightedBorda(t *testing.T) {
+	results, res, report := weightedBorda(t)
+
+	headers, rows, index, rankCol := rankingSummary(results, res, report)
+
+	if want := []string{"Option", "Score", "Weighted"}; !reflect.DeepEqual(headers, want) {
+		t.Errorf("headers = %v, want %v", headers, want)
+	}
+
+	want := [][]string{{"Sushi", "5", "7"}, {"Tacos", "3", "4.5"}, {"Pizza", "4", "3.5"}}
+	if !reflect.DeepEqual(rows, want) {
+		t.Errorf("rows = %v, want %v", rows, want)
+	}
+
+	if !reflect.DeepEqual(index, []int{1, 2, 0}) || rankCol != 2 {
+		t.Errorf("index = %v, rankCol = %d, want [1 2 0], 2", index, rankCol)
+	}
+}
+
+func TestRankingChart_WeightedBorda(t *testing.T) {
+	results, res, report := weightedBorda(t)
+
+	want := []output.Bar{
+		{Label: "Sushi", Value: 7, Display: "7  (5 raw)"},
+		{Label: "Tacos", Value: 4.5, Display: "4.5  (3 raw)"},
+		{Label: "Pizza", Value: 3.5, Display: "3.5  (4 raw)"},
+	}
+	if got := rankingChart(results, res, report); !reflect.DeepEqual(got, want) {
+		t.Errorf("bars = %+v, want %+v", got, want)
+	}
+
+	// Without weights the chart keeps the raw Borda scores.
+	if got := rankingChart(results, ranking.Count(ranking.Borda, rankingBallots(results), 3), nil); got[0].Label != "Sushi" || got[1].Label != "Pizza" {
+		t.Errorf("unweighted bars = %+v", got)
+	}
+}
+
+func TestCountRanking_FractionalWeights(t *testing.T) {
+	ballots := []ranking.Ballot{{0, 1}, {1, 0}}
+
+	if _, err := countRanking(ranking.IRV, ballots, []float64{0.5, 1}, 2); err == nil {
+		t.Error("countRanking(irv) with fractional weights: want error")
+	}
+
+	res, err := countRanking(ranking.IRV, ballots, []float64{3, 1}, 2)
+	if err != nil || res.Order[0] != 0 {
+		t.Errorf("countRanking(irv) = %v, %v, want option 0 first", res.Order, err)
+	}
+}
@@ -0,0 +1,166 @@
+package cmd
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"slices"
+	"strings"
+
+	"github.com/dedene/strawpoll-cli/internal/api"
+	"github.com/dedene/strawpoll-cli/internal/ranking"
+)
+
+// WinnerFlags are the winner and tie-break flags shared by the results
+// commands.
+type WinnerFlags struct {
+	Winners   int    `help:"Number of winners (default: the poll's number_of_winners, else 1)"`
+	TieBreak  string `help:"Resolve a tie for the last winning place: none, first-preference (ranking polls), random, position" default:"none" enum:"none,first-preference,random,position" name:"tie-break"`
+	Seed      uint64 `help:"Seed for --tie-break random (default: a random seed, reported in JSON output)"`
+	FailOnTie bool   `help:"Exit with status 6 when a tie for a winning place is left unresolved" name:"fail-on-tie"`
+}
+
+// winnerCount returns --winners, else the poll's number_of_winners, else 1.
+func (w *WinnerFlags) winnerCount(poll *api.Poll) int {
+	if w.Winners > 0 {
+		return w.Winners
+	}
+
+	if poll != nil && poll.PollConfig != nil && poll.PollConfig.NumberOfWinners != nil {
+		return max(*poll.PollConfig.NumberOfWinners, 1)
+	}
+
+	return 1
+}
+
+// decide picks the winners from one score per option. firstPrefs feeds
+// --tie-break first-preference; it is nil for poll types whose score is
+// already the first-preference count, leaving such ties unresolved.
+func (w *WinnerFlags) decide(poll *api.Poll, options []*api.PollOption, scores, firstPrefs []float64) (*winnersJSON, error) {
+	rule, err := ranking.ParseTieBreak(w.TieBreak)
+	if err != nil {
+		return nil, &ExitError{Code: CodeUsage, Err: err}
+	}
+
+	seed := w.Seed
+	if rule == ranking.TieBreakRandom && seed == 0 {
+		seed = rand.Uint64()
+	}
+
+	out := ranking.Winners(scores, w.winnerCount(poll), ranking.TieBreaker{
+		Rule:             rule,
+		FirstPreferences: firstPrefs,
+		Seed:             seed,
+	})
+
+	return newWinnersJSON(out, options, rule, seed), nil
+}
+
+// check returns the --fail-on-tie error once output has been written.
+func (w *WinnerFlags) check(res *winnersJSON) error {
+	if !w.FailOnTie || res.Tie == nil || res.Tie.Broken {
+		return nil
+	}
+
+	places := "the last winning place"
+	if res.Tie.Seats > 1 {
+		places = fmt.Sprintf("the last %d winning places", res.Tie.Seats)
+	}
+
+	return &ExitError{Code: CodeTie, Err: fmt.Errorf("tie between %s for %s",
+		strings.Join(res.Tie.Options, ", "), places)}
+}
+
+// winnersJSON is the winner section of enriched results output.
+type winnersJSON struct {
+	Winners []string `json:"winners"`
+	Tie     *tieJSON `json:"tie,omitempty"`
+
+	winners map[int]bool
+	tied    map[int]bool
+}
+
+type tieJSON struct {
+	Options  []string `json:"options"`
+	Seats    int      `json:"seats"`
+	Broken   bool     `json:"broken"`
+	TieBreak string   `json:"tieBreak"`
+	Seed     uint64   `json:"seed,omitempty"`
+}
+
+func newWinnersJSON(out ranking.Outcome, options []*api.PollOption, rule ranking.TieBreak, seed uint64) *winnersJSON {
+	names := func(idx []int) []string {
+		s := make([]string, len(idx))
+		for k, i := range idx {
+			s[k] = options[i].Value
+		}
+
+		return s
+	}
+
+	res := &winnersJSON{
+		Winners: names(out.Winners),
+		winners: make(map[int]bool, len(out.Winners)),
+		tied:    make(map[int]bool),
+	}
+
+	for _, i := range out.Winners {
+		res.winners[i] = true
+	}
+
+	if out.Tie == nil {
+		return res
+	}
+
+	for _, i := range out.Tie.Options {
+		res.tied[i] = true
+	}
+
+	res.Tie = &tieJSON{
+		Options:  names(out.Tie.Options),
+		Seats:    out.Tie.Seats,
+		Broken:   out.Tie.Broken,
+		TieBreak: string(rule),
+	}
+
+	if rule == ranking.TieBreakRandom {
+		res.Tie.Seed = seed
+	}
+
+	return res
+}
+
+// label returns the Winner column cell for option i: "x" for a winner,
+// "x (tie-break)" for a winner decided by the tie-break rule, and "tie"
+// for an option in an unresolved tie.
+func (w *winnersJSON) label(i int) string {
+	switch {
+	case w.winners[i] && w.tied[i]:
+		return "x (tie-break)"
+	case w.winners[i]:
+		return "x"
+	case w.tied[i] && !w.Tie.Broken:
+		return "tie"
+	default:
+		return ""
+	}
+}
+
+// withWinners appends a Winner column; index maps each row to its option.
+func withWinners(headers []string, rows [][]string, index []int, w *winnersJSON) ([]string, [][]string) {
+	out := make([][]string, len(rows))
+	for r, row := range rows {
+		out[r] = append(slices.Clip(row), w.label(index[r]))
+	}
+
+	return append(slices.Clip(headers), "Winner"), out
+}
+
+// intScores converts per-option counts to scores for ranking.Winners.
+func intScores(counts []int) []float64 {
+	scores := make([]float64, len(counts))
+	for i, c := range counts {
+		scores[i] = float64(c)
+	}
+
+	return scores
+}
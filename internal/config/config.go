@@ -5,6 +5,8 @@ import (
 	"os"
 
 	"gopkg.in/yaml.v3"
+
+	"github.com/dedene/strawpoll-cli/internal/theme"
 )
 
 // File represents the strawpoll-cli YAML configuration.
@@ -17,6 +19,17 @@ type File struct {
 	AllowVPN          *bool  `yaml:"allow_vpn_users,omitempty" json:"allow_vpn_users,omitempty"`
 	HideParticipants  *bool  `yaml:"hide_participants,omitempty" json:"hide_participants,omitempty"`
 	EditVotePerms     string `yaml:"edit_vote_permissions,omitempty" json:"edit_vote_permissions,omitempty"`
+	Pager             string `yaml:"pager,omitempty" json:"pager,omitempty"`
+	Theme             string `yaml:"theme,omitempty" json:"theme,omitempty"`
+	Accessible        *bool  `yaml:"accessible,omitempty" json:"accessible,omitempty"`
+	TimeFormat        string `yaml:"time_format,omitempty" json:"time_format,omitempty"`
+	Clock             string `yaml:"clock,omitempty" json:"clock,omitempty"`
+
+	// Aliases maps user-defined command names to the argument string they expand to.
+	Aliases map[string]string `yaml:"aliases,omitempty" json:"aliases,omitempty"`
+
+	// Themes defines custom color themes selectable with the theme key.
+	Themes map[string]theme.Theme `yaml:"themes,omitempty" json:"themes,omitempty"`
 }
 
 // ConfigExists checks whether the config file exists on disk.
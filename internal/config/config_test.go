@@ -3,6 +3,7 @@ package config
 import (
 	"os"
 	"path/filepath"
+	"reflect"
 	"testing"
 )
 
@@ -14,7 +15,7 @@ func TestReadConfigMissing(t *testing.T) {
 		t.Fatalf("readConfigFrom(missing) error: %v", err)
 	}
 
-	if cfg != (File{}) {
+	if !reflect.DeepEqual(cfg, File{}) {
 		t.Errorf("readConfigFrom(missing) = %+v, want zero File{}", cfg)
 	}
 }
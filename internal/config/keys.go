@@ -0,0 +1,296 @@
+package config
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/dedene/strawpoll-cli/internal/api"
+	"github.com/dedene/strawpoll-cli/internal/theme"
+)
+
+// KeyType is the value type of a configuration key.
+type KeyType string
+
+const (
+	KeyTypeString KeyType = "string"
+	KeyTypeBool   KeyType = "bool"
+	KeyTypeEnum   KeyType = "enum"
+)
+
+// Key describes a single configuration key: its type, allowed values,
+// and how it maps onto the File struct.
+type Key struct {
+	Name        string
+	Type        KeyType
+	Allowed     []string
+	Description string
+
+	get   func(cfg *File) (string, bool)
+	set   func(cfg *File, value string)
+	unset func(cfg *File)
+	// check validates values that depend on the rest of the config.
+	check func(cfg *File, value string) error
+}
+
+// keys is the registry of all supported configuration keys, in display order.
+var keys = []Key{
+	enumKey("keyring_backend", "Keyring backend for storing the API key",
+		[]string{"auto", "keychain", "file"},
+		func(cfg *File) *string { return &cfg.KeyringBackend }),
+	enumKey("dupcheck", "Default duplicate vote checking for new polls",
+		[]string{api.DupcheckIP, api.DupcheckSession, api.DupcheckNone},
+		func(cfg *File) *string { return &cfg.Dupcheck }),
+	enumKey("results_visibility", "Default results visibility for new polls",
+		[]string{
+			api.ResultsVisibilityAlways, api.ResultsVisibilityAfterDeadline,
+			api.ResultsVisibilityAfterVote, api.ResultsVisibilityHidden,
+		},
+		func(cfg *File) *string { return &cfg.ResultsVisibility }),
+	boolKey("is_private", "Hide new polls from public listings",
+		func(cfg *File) **bool { return &cfg.IsPrivate }),
+	boolKey("allow_comments", "Allow comments on new polls",
+		func(cfg *File) **bool { return &cfg.AllowComments }),
+	boolKey("allow_vpn_users", "Allow VPN users to vote on new polls",
+		func(cfg *File) **bool { return &cfg.AllowVPN }),
+	boolKey("hide_participants", "Hide participant names on new polls",
+		func(cfg *File) **bool { return &cfg.HideParticipants }),
+	enumKey("edit_vote_permissions", "Default for who may edit votes on new polls",
+		[]string{
+			api.EditVotePermsAdmin, api.EditVotePermsAdminVoter,
+			api.EditVotePermsVoter, api.EditVotePermsNobody,
+		},
+		func(cfg *File) *string { return &cfg.EditVotePerms }),
+	stringKey("pager", "Pager for long table output, e.g. \"less -FRX\" (\"cat\" disables paging)",
+		func(cfg *File) *string { return &cfg.Pager }),
+	themeKey(),
+	boolKey("accessible", "Describe tables as sentences and use accessible prompts, for screen readers",
+		func(cfg *File) **bool { return &cfg.Accessible }),
+	enumKey("time_format", "How timestamps are shown: dates, relative to now, or ISO 8601",
+		[]string{"absolute", "relative", "iso"},
+		func(cfg *File) *string { return &cfg.TimeFormat }),
+	enumKey("clock", "Clock for times of day (auto follows the locale)",
+		[]string{"auto", "12h", "24h"},
+		func(cfg *File) *string { return &cfg.Clock }),
+}
+
+// themeKey accepts the built-in themes and those defined under themes.
+func themeKey() Key {
+	k := stringKey("theme", "Color theme for tables and interactive screens (built-in or defined under themes)",
+		func(cfg *File) *string { return &cfg.Theme })
+	k.Allowed = theme.Names()
+	k.check = func(cfg *File, value string) error {
+		_, err := theme.Lookup(value, cfg.Themes)
+
+		return err
+	}
+
+	return k
+}
+
+func stringKey(name, desc string, field func(*File) *string) Key {
+	k := enumKey(name, desc, nil, field)
+	k.Type = KeyTypeString
+
+	return k
+}
+
+func enumKey(name, desc string, allowed []string, field func(*File) *string) Key {
+	return Key{
+		Name:        name,
+		Type:        KeyTypeEnum,
+		Allowed:     allowed,
+		Description: desc,
+		get: func(cfg *File) (string, bool) {
+			v := *field(cfg)
+
+			return v, v != ""
+		},
+		set:   func(cfg *File, value string) { *field(cfg) = value },
+		unset: func(cfg *File) { *field(cfg) = "" },
+	}
+}
+
+func boolKey(name, desc string, field func(*File) **bool) Key {
+	return Key{
+		Name:        name,
+		Type:        KeyTypeBool,
+		Description: desc,
+		get: func(cfg *File) (string, bool) {
+			p := *field(cfg)
+			if p == nil {
+				return "", false
+			}
+
+			return strconv.FormatBool(*p), true
+		},
+		set: func(cfg *File, value string) {
+			b, _ := ParseBool(value)
+			*field(cfg) = &b
+		},
+		unset: func(cfg *File) { *field(cfg) = nil },
+	}
+}
+
+// Keys returns all supported configuration keys in display order.
+func Keys() []Key {
+	return slices.Clone(keys)
+}
+
+// KeyNames returns the names of all supported configuration keys.
+func KeyNames() []string {
+	names := make([]string, len(keys))
+	for i, k := range keys {
+		names[i] = k.Name
+	}
+
+	return names
+}
+
+// LookupKey returns the key with the given name.
+func LookupKey(name string) (Key, error) {
+	for _, k := range keys {
+		if k.Name == name {
+			return k, nil
+		}
+	}
+
+	return Key{}, fmt.Errorf("unknown config key: %s\n\nValid keys: %s", name, strings.Join(KeyNames(), ", "))
+}
+
+// Validate checks that value is acceptable for the key.
+func (k Key) Validate(value string) error {
+	switch k.Type {
+	case KeyTypeBool:
+		if _, err := ParseBool(value); err != nil {
+			return fmt.Errorf("invalid boolean for %s: %w", k.Name, err)
+		}
+	case KeyTypeEnum:
+		if !slices.Contains(k.Allowed, value) {
+			return fmt.Errorf("invalid value %q for %s (expected one of: %s)", value, k.Name, strings.Join(k.Allowed, ", "))
+		}
+	}
+
+	return nil
+}
+
+// Get returns the key's value in cfg and whether it is set.
+func (k Key) Get(cfg *File) (string, bool) {
+	return k.get(cfg)
+}
+
+// Set validates value and stores it in cfg.
+func (k Key) Set(cfg *File, value string) error {
+	if k.Type == KeyTypeBool {
+		value = strings.ToLower(strings.TrimSpace(value))
+	}
+
+	if err := k.Validate(value); err != nil {
+		return err
+	}
+
+	if k.check != nil {
+		if err := k.check(cfg, value); err != nil {
+			return err
+		}
+	}
+
+	k.set(cfg, value)
+
+	return nil
+}
+
+// Unset clears the key in cfg so the built-in default applies again.
+func (k Key) Unset(cfg *File) {
+	k.unset(cfg)
+}
+
+// ParseBool parses the boolean spellings accepted in config values.
+func ParseBool(s string) (bool, error) {
+	switch strings.ToLower(strings.TrimSpace(s)) {
+	case "true", "1", "yes":
+		return true, nil
+	case "false", "0", "no":
+		return false, nil
+	default:
+		return false, fmt.Errorf("expected true/false, got %q", s)
+	}
+}
+
+// JSONSchema returns a JSON Schema document describing config.yaml,
+// for use with YAML language servers and editors.
+func JSONSchema() ([]byte, error) {
+	props := make(map[string]any, len(keys))
+
+	for _, k := range keys {
+		prop := map[string]any{"description": k.Description}
+
+		switch k.Type {
+		case KeyTypeBool:
+			prop["type"] = "boolean"
+		case KeyTypeEnum:
+			prop["type"] = "string"
+			prop["enum"] = k.Allowed
+		default:
+			prop["type"] = "string"
+		}
+
+		props[k.Name] = prop
+	}
+
+	color := map[string]any{
+		"description": "Color as ANSI number, 256-color index or hex, or separate light and dark variants",
+		"oneOf": []any{
+			map[string]any{"type": "string"},
+			map[string]any{
+				"type": "object",
+				"properties": map[string]any{
+					"light": map[string]any{"type": "string"},
+					"dark":  map[string]any{"type": "string"},
+				},
+				"additionalProperties": false,
+			},
+		},
+	}
+
+	themeProps := map[string]any{
+		"base": map[string]any{"description": "Built-in theme to start from", "type": "string", "enum": theme.Names()},
+	}
+	for _, name := range []string{"header", "accent", "success", "error", "warning", "muted"} {
+		themeProps[name] = color
+	}
+
+	props["themes"] = map[string]any{
+		"description": "Custom color themes, selected with the theme key",
+		"type":        "object",
+		"additionalProperties": map[string]any{
+			"type":                 "object",
+			"properties":           themeProps,
+			"additionalProperties": false,
+		},
+	}
+
+	props["aliases"] = map[string]any{
+		"description":          "Command aliases expanded before parsing ($1..$9 and $@ are substituted)",
+		"type":                 "object",
+		"additionalProperties": map[string]any{"type": "string"},
+	}
+
+	schema := map[string]any{
+		"$schema":              "https://json-schema.org/draft/2020-12/schema",
+		"$id":                  "https://github.com/dedene/strawpoll-cli/config.schema.json",
+		"title":                "strawpoll-cli configuration",
+		"type":                 "object",
+		"properties":           props,
+		"additionalProperties": false,
+	}
+
+	b, err := json.MarshalIndent(schema, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("encode schema: %w", err)
+	}
+
+	return append(b, '\n'), nil
+}
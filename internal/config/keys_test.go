@@ -0,0 +1,124 @@
+package config
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestLookupKey(t *testing.T) {
+	if _, err := LookupKey("dupcheck"); err != nil {
+		t.Fatalf("LookupKey(dupcheck) error: %v", err)
+	}
+
+	if _, err := LookupKey("nope"); err == nil {
+		t.Error("LookupKey(nope) = nil error, want unknown key error")
+	}
+}
+
+func TestKeySet(t *testing.T) {
+	tests := []struct {
+		key     string
+		value   string
+		wantErr bool
+		want    string
+	}{
+		{"dupcheck", "session", false, "session"},
+		{"dupcheck", "cookie", true, ""},
+		{"results_visibility", "after_vote", false, "after_vote"},
+		{"results_visibility", "never", true, ""},
+		{"edit_vote_permissions", "nobody", false, "nobody"},
+		{"edit_vote_permissions", "everyone", true, ""},
+		{"keyring_backend", "file", false, "file"},
+		{"pager", "less -R", false, "less -R"},
+		{"theme", "solarized", false, "solarized"},
+		{"theme", "neon", true, ""},
+		{"time_format", "relative", false, "relative"},
+		{"clock", "13h", true, ""},
+		{"is_private", "YES", false, "true"},
+		{"allow_comments", "0", false, "false"},
+		{"hide_participants", "maybe", true, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.key+"="+tt.value, func(t *testing.T) {
+			k, err := LookupKey(tt.key)
+			if err != nil {
+				t.Fatalf("LookupKey: %v", err)
+			}
+
+			var cfg File
+
+			err = k.Set(&cfg, tt.value)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("Set(%q) = nil error, want error", tt.value)
+				}
+
+				if _, ok := k.Get(&cfg); ok {
+					t.Error("invalid value was stored")
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("Set(%q) error: %v", tt.value, err)
+			}
+
+			got, ok := k.Get(&cfg)
+			if !ok || got != tt.want {
+				t.Errorf("Get() = %q, %v; want %q, true", got, ok, tt.want)
+			}
+		})
+	}
+}
+
+func TestKeyUnset(t *testing.T) {
+	priv := true
+	cfg := File{Dupcheck: "ip", IsPrivate: &priv}
+
+	for _, name := range []string{"dupcheck", "is_private"} {
+		k, err := LookupKey(name)
+		if err != nil {
+			t.Fatalf("LookupKey(%s): %v", name, err)
+		}
+
+		k.Unset(&cfg)
+
+		if _, ok := k.Get(&cfg); ok {
+			t.Errorf("%s still set after Unset", name)
+		}
+	}
+}
+
+func TestJSONSchema(t *testing.T) {
+	b, err := JSONSchema()
+	if err != nil {
+		t.Fatalf("JSONSchema() error: %v", err)
+	}
+
+	var schema struct {
+		Properties map[string]struct {
+			Type string   `json:"type"`
+			Enum []string `json:"enum"`
+		} `json:"properties"`
+	}
+
+	if err := json.Unmarshal(b, &schema); err != nil {
+		t.Fatalf("schema is not valid JSON: %v", err)
+	}
+
+	for _, name := range KeyNames() {
+		if _, ok := schema.Properties[name]; !ok {
+			t.Errorf("schema missing property %q", name)
+		}
+	}
+
+	if got := schema.Properties["is_private"].Type; got != "boolean" {
+		t.Errorf("is_private type = %q, want boolean", got)
+	}
+
+	if got := len(schema.Properties["dupcheck"].Enum); got != 3 {
+		t.Errorf("dupcheck enum has %d values, want 3", got)
+	}
+}
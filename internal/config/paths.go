@@ -60,3 +60,12 @@ func EnsureKeyringDir() (string, error) {
 
 	return dir, nil
 }
+
+func SnapshotDir() (string, error) {
+	dir, err := Dir()
+	if err != nil {
+		return "", err
+	}
+
+	return filepath.Join(dir, "snapshots"), nil
+}
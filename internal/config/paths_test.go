@@ -59,3 +59,14 @@ func TestEnsureKeyringDir(t *testing.T) {
 		t.Errorf("EnsureKeyringDir() = %q, want suffix %q", dir, "keyring")
 	}
 }
+
+func TestSnapshotDir(t *testing.T) {
+	p, err := SnapshotDir()
+	if err != nil {
+		t.Fatalf("SnapshotDir() error: %v", err)
+	}
+
+	if !strings.HasSuffix(p, "snapshots") {
+		t.Errorf("SnapshotDir() = %q, want suffix %q", p, "snapshots")
+	}
+}
@@ -0,0 +1,100 @@
+package output
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// WriteSentences writes each row as one linear sentence for screen readers,
+// e.g. "Option Pizza: 12 votes, 40.0 percent, rank 1 of 3." The first
+// column names the row; the remaining cells are phrased by their header.
+func WriteSentences(w io.Writer, headers []string, rows [][]string) error {
+	for _, row := range rows {
+		if _, err := fmt.Fprintln(w, Sentence(headers, row, len(rows))); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Sentence phrases a table row. Numbers read as "12 votes", percentages as
+// "40.0 percent", a Rank column as "rank 1 of n", cells marked "x" as their
+// header, and other cells as "header value". Empty cells are skipped.
+func Sentence(headers, row []string, n int) string {
+	header := func(i int) string {
+		if i < len(headers) {
+			return headers[i]
+		}
+
+		return ""
+	}
+
+	var subject string
+	if len(row) > 0 {
+		subject = strings.TrimSpace(header(0) + " " + row[0])
+	}
+
+	var parts []string
+
+	for i := 1; i < len(row); i++ {
+		if phrase := cellPhrase(header(i), strings.TrimSpace(row[i]), n); phrase != "" {
+			parts = append(parts, phrase)
+		}
+	}
+
+	if len(parts) == 0 {
+		parts = []string{"none"}
+	}
+
+	return subject + ": " + strings.Join(strings.Fields(strings.Join(parts, ", ")), " ") + "."
+}
+
+func cellPhrase(header, value string, n int) string {
+	switch {
+	case value == "" || value == "-":
+		return ""
+	case strings.EqualFold(header, "rank"):
+		return fmt.Sprintf("rank %s of %d", value, n)
+	case strings.EqualFold(value, "x"):
+		return header
+	}
+
+	if pct, ok := strings.CutSuffix(value, "%"); ok && isNumber(pct) {
+		return pct + " percent"
+	}
+
+	if isNumber(value) {
+		unit := strings.ToLower(header)
+		if value == "1" {
+			unit = strings.TrimSuffix(unit, "s")
+		}
+
+		return strings.TrimSpace(value + " " + unit)
+	}
+
+	return strings.TrimSpace(header + " " + value)
+}
+
+func isNumber(s string) bool {
+	_, err := strconv.ParseFloat(s, 64)
+
+	return err == nil
+}
+
+// writeKVSentences writes key-value pairs as "Key: value." lines.
+func writeKVSentences(w io.Writer, pairs [][2]string) error {
+	for _, kv := range pairs {
+		if strings.TrimSpace(kv[1]) == "" {
+			continue
+		}
+
+		if _, err := fmt.Fprintf(w, "%s: %s.\n", kv[0], strings.TrimSuffix(kv[1], ".")); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,77 @@
+package output
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestSentence(t *testing.T) {
+	tests := []struct {
+		name    string
+		headers []string
+		row     []string
+		want    string
+	}{
+		{
+			name:    "results",
+			headers: []string{"Option", "Votes", "Percentage", "Rank"},
+			row:     []string{"Pizza", "12", "40.0%", "1"},
+			want:    "Option Pizza: 12 votes, 40.0 percent, rank 1 of 3.",
+		},
+		{
+			name:    "singular",
+			headers: []string{"Option", "Votes"},
+			row:     []string{"Sushi", "1"},
+			want:    "Option Sushi: 1 vote.",
+		},
+		{
+			name:    "marked cells",
+			headers: []string{"Name", "Pizza", "Sushi", "Tacos"},
+			row:     []string{"Alice", "x", " ", "x"},
+			want:    "Name Alice: Pizza, Tacos.",
+		},
+		{
+			name:    "nothing marked",
+			headers: []string{"Name", "Pizza"},
+			row:     []string{"Bob", " "},
+			want:    "Name Bob: none.",
+		},
+		{
+			name:    "text cells",
+			headers: []string{"Slot", "Alice", "Total"},
+			row:     []string{"Mon 10:00", "Maybe", "0+1/1"},
+			want:    "Slot Mon 10:00: Alice Maybe, Total 0+1/1.",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Sentence(tt.headers, tt.row, 3); got != tt.want {
+				t.Errorf("Sentence() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFormatterAccessible(t *testing.T) {
+	var buf bytes.Buffer
+
+	f := &Formatter{Writer: &buf, Colors: &Colors{}, Accessible: true, Table: TableOptions{Width: 20}}
+	if err := f.OutputGrid(nil, []string{"Slot", "Alice", "Bob"}, [][]string{{"Mon", "Yes", "No"}}, "Name"); err != nil {
+		t.Fatalf("OutputGrid: %v", err)
+	}
+
+	if buf.String() != "Slot Mon: Alice Yes, Bob No.\n" {
+		t.Errorf("grid = %q", buf.String())
+	}
+
+	buf.Reset()
+
+	if err := f.OutputSingle(nil, [][2]string{{"Title", "Lunch"}, {"Deadline", ""}}); err != nil {
+		t.Fatalf("OutputSingle: %v", err)
+	}
+
+	if buf.String() != "Title: Lunch.\n" {
+		t.Errorf("single = %q", buf.String())
+	}
+}
@@ -0,0 +1,153 @@
+package output
+
+import (
+	"fmt"
+	"io"
+	"math"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+// Bar is a single labelled value in a bar chart.
+type Bar struct {
+	Label   string
+	Value   float64
+	Display string // text shown after the bar, e.g. "12  40.0%"
+}
+
+// ChartOptions controls bar chart rendering.
+type ChartOptions struct {
+	// Width is the total line width; DefaultWidth if zero.
+	Width int
+	// ASCII draws bars with '#' instead of Unicode blocks.
+	ASCII bool
+	// Colors highlights the leading bar(s) when enabled.
+	Colors *Colors
+}
+
+const (
+	chartMinBarWidth = 10
+	chartTieMark     = "(tie)"
+)
+
+// eighths are the partial block characters for 1/8 .. 7/8 of a cell.
+var eighths = []string{"", "▏", "▎", "▍", "▌", "▋", "▊", "▉"}
+
+// RenderBarChart writes one proportional horizontal bar per entry, scaled so
+// the largest value fills the available width. Leading bars are highlighted
+// and bars sharing a non-zero value with another bar are marked as ties.
+func RenderBarChart(w io.Writer, bars []Bar, opts ChartOptions) error {
+	if len(bars) == 0 {
+		return nil
+	}
+
+	width := opts.Width
+	if width <= 0 {
+		width = DefaultWidth
+	}
+
+	peak := 0.0
+	counts := make(map[float64]int, len(bars))
+	labelWidth, displayWidth := 0, 0
+
+	for _, b := range bars {
+		peak = math.Max(peak, b.Value)
+		counts[b.Value]++
+		labelWidth = max(labelWidth, lipgloss.Width(b.Label))
+		displayWidth = max(displayWidth, lipgloss.Width(b.Display))
+	}
+
+	labelWidth = min(labelWidth, max(width/3, 8))
+	barWidth := max(width-labelWidth-displayWidth-len(chartTieMark)-3, chartMinBarWidth)
+
+	for _, b := range bars {
+		label := Truncate(b.Label, labelWidth)
+		label += strings.Repeat(" ", labelWidth-lipgloss.Width(label))
+
+		frac := 0.0
+		if peak > 0 {
+			frac = b.Value / peak
+		}
+
+		bar := drawBar(frac, barWidth, opts.ASCII)
+		pad := strings.Repeat(" ", barWidth-lipgloss.Width(bar))
+
+		leader := peak > 0 && b.Value == peak
+		if leader && opts.Colors != nil && opts.Colors.Enabled() {
+			bar = opts.Colors.Success(bar)
+			label = opts.Colors.Bold(label)
+		}
+
+		line := fmt.Sprintf("%s %s%s %*s", label, bar, pad, displayWidth, b.Display)
+		if b.Value > 0 && counts[b.Value] > 1 {
+			line += " " + chartTieMark
+		}
+
+		if _, err := fmt.Fprintln(w, strings.TrimRight(line, " ")); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// drawBar renders frac (0..1) of width cells, using eighth blocks for the
+// fractional cell in Unicode mode.
+func drawBar(frac float64, width int, ascii bool) string {
+	if ascii {
+		return strings.Repeat("#", int(math.Round(frac*float64(width))))
+	}
+
+	units := int(math.Round(frac * float64(width) * 8))
+	full, part := units/8, units%8
+
+	return strings.Repeat("█", full) + eighths[part]
+}
+
+// Chart renders bars sized to the formatter's writer. Plain mode and disabled
+// colors fall back to uncolored ASCII bars.
+func (f *Formatter) Chart(bars []Bar) error {
+	opts := ChartOptions{
+		Width:  TerminalWidth(f.Writer),
+		ASCII:  f.Mode == ModePlain || !f.Colors.Enabled(),
+		Colors: f.Colors,
+	}
+
+	if f.Mode == ModePlain {
+		opts.Colors = nil
+	}
+
+	return RenderBarChart(f.Writer, bars, opts)
+}
+
+var (
+	sparkBlocks = []rune("▁▂▃▄▅▆▇█")
+	sparkASCII  = []rune("_.-=+*#@")
+)
+
+// Sparkline renders values as one character each, scaled so the largest
+// value is the tallest. Zero values use the lowest level.
+func Sparkline(values []int, ascii bool) string {
+	levels := sparkBlocks
+	if ascii {
+		levels = sparkASCII
+	}
+
+	peak := 0
+	for _, v := range values {
+		peak = max(peak, v)
+	}
+
+	out := make([]rune, len(values))
+	for i, v := range values {
+		level := 0
+		if peak > 0 && v > 0 {
+			level = int(math.Ceil(float64(v) / float64(peak) * float64(len(levels)-1)))
+		}
+
+		out[i] = levels[level]
+	}
+
+	return string(out)
+}
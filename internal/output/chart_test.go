@@ -0,0 +1,129 @@
+package output
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func TestRenderBarChart_ASCII(t *testing.T) {
+	bars := []Bar{
+		{Label: "Pizza", Value: 10, Display: "10"},
+		{Label: "Sushi", Value: 5, Display: "5"},
+		{Label: "Tacos", Value: 0, Display: "0"},
+	}
+
+	var buf bytes.Buffer
+	if err := RenderBarChart(&buf, bars, ChartOptions{Width: 40, ASCII: true}); err != nil {
+		t.Fatalf("RenderBarChart error: %v", err)
+	}
+
+	lines := strings.Split(strings.TrimRight(buf.String(), "\n"), "\n")
+	if len(lines) != 3 {
+		t.Fatalf("got %d lines, want 3:\n%s", len(lines), buf.String())
+	}
+
+	full := strings.Count(lines[0], "#")
+	half := strings.Count(lines[1], "#")
+
+	if full == 0 || half*2 < full-1 || half*2 > full+1 {
+		t.Errorf("bar lengths = %d, %d; want second to be half of first", full, half)
+	}
+
+	if strings.Contains(lines[2], "#") {
+		t.Errorf("zero value should have no bar: %q", lines[2])
+	}
+
+	for _, l := range lines {
+		if len([]rune(l)) > 40 {
+			t.Errorf("line exceeds width 40: %q", l)
+		}
+	}
+}
+
+func TestRenderBarChart_Unicode(t *testing.T) {
+	bars := []Bar{
+		{Label: "A", Value: 8, Display: "8"},
+		{Label: "B", Value: 3, Display: "3"},
+	}
+
+	var buf bytes.Buffer
+	if err := RenderBarChart(&buf, bars, ChartOptions{Width: 40}); err != nil {
+		t.Fatalf("RenderBarChart error: %v", err)
+	}
+
+	out := buf.String()
+	if !strings.Contains(out, "█") {
+		t.Error("expected Unicode block characters")
+	}
+
+	if strings.Contains(out, "#") {
+		t.Error("unexpected ASCII bars in Unicode mode")
+	}
+}
+
+func TestRenderBarChart_Ties(t *testing.T) {
+	bars := []Bar{
+		{Label: "A", Value: 4, Display: "4"},
+		{Label: "B", Value: 4, Display: "4"},
+		{Label: "C", Value: 1, Display: "1"},
+		{Label: "D", Value: 0, Display: "0"},
+		{Label: "E", Value: 0, Display: "0"},
+	}
+
+	var buf bytes.Buffer
+	if err := RenderBarChart(&buf, bars, ChartOptions{Width: 60, ASCII: true}); err != nil {
+		t.Fatalf("RenderBarChart error: %v", err)
+	}
+
+	lines := strings.Split(strings.TrimRight(buf.String(), "\n"), "\n")
+	want := []bool{true, true, false, false, false}
+
+	for i, l := range lines {
+		if got := strings.HasSuffix(l, chartTieMark); got != want[i] {
+			t.Errorf("line %d tie = %v, want %v: %q", i, got, want[i], l)
+		}
+	}
+}
+
+func TestRenderBarChart_TruncatesLabels(t *testing.T) {
+	bars := []Bar{{Label: strings.Repeat("x", 100), Value: 1, Display: "1"}}
+
+	var buf bytes.Buffer
+	if err := RenderBarChart(&buf, bars, ChartOptions{Width: 30, ASCII: true}); err != nil {
+		t.Fatalf("RenderBarChart error: %v", err)
+	}
+
+	if !strings.Contains(buf.String(), "…") {
+		t.Errorf("expected truncated label, got %q", buf.String())
+	}
+}
+
+func TestDrawBar_PartialBlocks(t *testing.T) {
+	if got := drawBar(0.5, 3, false); got != "█▌" {
+		t.Errorf("drawBar(0.5, 3) = %q, want %q", got, "█▌")
+	}
+
+	if got := drawBar(1, 4, true); got != "####" {
+		t.Errorf("drawBar(1, 4, ascii) = %q, want %q", got, "####")
+	}
+}
+
+func TestSparkline(t *testing.T) {
+	tests := []struct {
+		values []int
+		ascii  bool
+		want   string
+	}{
+		{[]int{0, 1, 7, 7}, false, "▁▂██"},
+		{[]int{0, 2, 4}, true, "_+@"},
+		{[]int{0, 0}, false, "▁▁"},
+		{nil, false, ""},
+	}
+
+	for _, tt := range tests {
+		if got := Sparkline(tt.values, tt.ascii); got != tt.want {
+			t.Errorf("Sparkline(%v, %v) = %q, want %q", tt.values, tt.ascii, got, tt.want)
+		}
+	}
+}
@@ -5,15 +5,23 @@ import (
 
 	"github.com/charmbracelet/lipgloss"
 	"github.com/muesli/termenv"
+
+	"github.com/dedene/strawpoll-cli/internal/theme"
 )
 
 // Colors provides terminal color support with profile detection.
 type Colors struct {
 	Profile termenv.Profile
 	enabled bool
+	palette theme.Palette
+	// pending defers resolving palette until colored output is produced:
+	// detecting the background queries the terminal, which can stall on
+	// terminals that do not answer.
+	pending bool
 }
 
-// NewColors creates a Colors instance.
+// NewColors creates a Colors instance using the active theme, resolved for
+// the terminal's light or dark background.
 // If noColor is true or NO_COLOR env is set, colors are disabled.
 func NewColors(noColor bool) *Colors {
 	if noColor || termenv.EnvNoColor() {
@@ -24,12 +32,28 @@ func NewColors(noColor bool) *Colors {
 	}
 
 	profile := termenv.EnvColorProfile()
+	if profile == termenv.Ascii {
+		return &Colors{Profile: profile}
+	}
+
 	return &Colors{
 		Profile: profile,
-		enabled: profile != termenv.Ascii,
+		enabled: true,
+		pending: true,
 	}
 }
 
+// colors returns the active theme's palette for the terminal background,
+// detecting the background on first use.
+func (c *Colors) colors() theme.Palette {
+	if c.pending {
+		c.palette = theme.Current().Palette(lipgloss.HasDarkBackground())
+		c.pending = false
+	}
+
+	return c.palette
+}
+
 // IsColorEnabled returns whether color should be enabled given the flag.
 func IsColorEnabled(noColor bool) bool {
 	if noColor {
@@ -54,36 +78,28 @@ func (c *Colors) Style() lipgloss.Style {
 	return lipgloss.NewStyle().Renderer(lipgloss.NewRenderer(os.Stdout))
 }
 
-// Success returns the string styled as green.
+// Success returns the string in the theme's success color.
 func (c *Colors) Success(s string) string {
-	if !c.enabled {
-		return s
-	}
-	return lipgloss.NewStyle().Foreground(lipgloss.Color("2")).Render(s)
+	return c.foreground(c.colors().Success, s)
 }
 
-// Error returns the string styled as red.
+// Error returns the string in the theme's error color.
 func (c *Colors) Error(s string) string {
-	if !c.enabled {
-		return s
-	}
-	return lipgloss.NewStyle().Foreground(lipgloss.Color("1")).Render(s)
+	return c.foreground(c.colors().Error, s)
 }
 
-// Warning returns the string styled as yellow.
+// Warning returns the string in the theme's warning color.
 func (c *Colors) Warning(s string) string {
-	if !c.enabled {
-		return s
-	}
-	return lipgloss.NewStyle().Foreground(lipgloss.Color("3")).Render(s)
+	return c.foreground(c.colors().Warning, s)
 }
 
-// Dim returns the string in faint style.
+// Dim returns the string in the theme's muted color, or faint when the
+// theme has none.
 func (c *Colors) Dim(s string) string {
 	if !c.enabled {
 		return s
 	}
-	return lipgloss.NewStyle().Faint(true).Render(s)
+	return c.mutedStyle().Render(s)
 }
 
 // Bold returns the string in bold.
@@ -93,3 +109,28 @@ func (c *Colors) Bold(s string) string {
 	}
 	return lipgloss.NewStyle().Bold(true).Render(s)
 }
+
+func (c *Colors) foreground(color, s string) string {
+	if !c.enabled || color == "" {
+		return s
+	}
+	return lipgloss.NewStyle().Foreground(lipgloss.Color(color)).Render(s)
+}
+
+// headerStyle is the style of table headers.
+func (c *Colors) headerStyle() lipgloss.Style {
+	style := lipgloss.NewStyle().Bold(true)
+	if header := c.colors().Header; header != "" {
+		style = style.Foreground(lipgloss.Color(header))
+	}
+	return style
+}
+
+// mutedStyle is the style of secondary text and alternate table rows.
+func (c *Colors) mutedStyle() lipgloss.Style {
+	muted := c.colors().Muted
+	if muted == "" {
+		return lipgloss.NewStyle().Faint(true)
+	}
+	return lipgloss.NewStyle().Foreground(lipgloss.Color(muted))
+}
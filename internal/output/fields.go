@@ -0,0 +1,196 @@
+package output
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+// fieldKey normalizes a column header or JSON key for --fields matching,
+// so "votes", "Votes", "vote_count" and "voteCount" compare by letters
+// and digits only.
+func fieldKey(s string) string {
+	var b strings.Builder
+
+	for _, r := range strings.ToLower(s) {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			b.WriteRune(r)
+		}
+	}
+
+	return b.String()
+}
+
+func unknownField(field string, available []string) error {
+	return fmt.Errorf("unknown field %q (available: %s)", field, strings.Join(available, ", "))
+}
+
+// selectColumns keeps the columns named by fields, in the order given.
+func selectColumns(headers []string, rows [][]string, fields []string) ([]string, [][]string, error) {
+	idx := make([]int, len(fields))
+
+	for i, field := range fields {
+		idx[i] = -1
+
+		for j, h := range headers {
+			if fieldKey(h) == fieldKey(field) {
+				idx[i] = j
+
+				break
+			}
+		}
+
+		if idx[i] < 0 {
+			return nil, nil, unknownField(field, headers)
+		}
+	}
+
+	pick := func(row []string) []string {
+		out := make([]string, len(idx))
+		for i, j := range idx {
+			if j < len(row) {
+				out[i] = row[j]
+			}
+		}
+
+		return out
+	}
+
+	selected := make([][]string, len(rows))
+	for i, row := range rows {
+		selected[i] = pick(row)
+	}
+
+	return pick(headers), selected, nil
+}
+
+// selectPairs keeps the key-value pairs named by fields, in the order given.
+func selectPairs(pairs [][2]string, fields []string) ([][2]string, error) {
+	keys := make([]string, len(pairs))
+	for i, kv := range pairs {
+		keys[i] = kv[0]
+	}
+
+	out := make([][2]string, 0, len(fields))
+
+	for _, field := range fields {
+		found := false
+
+		for _, kv := range pairs {
+			if fieldKey(kv[0]) == fieldKey(field) {
+				out = append(out, kv)
+				found = true
+
+				break
+			}
+		}
+
+		if !found {
+			return nil, unknownField(field, keys)
+		}
+	}
+
+	return out, nil
+}
+
+// orderedObject is a JSON object that keeps its keys in insertion order.
+type orderedObject []orderedField
+
+type orderedField struct {
+	Key   string
+	Value json.RawMessage
+}
+
+// MarshalJSON writes the fields in order.
+func (o orderedObject) MarshalJSON() ([]byte, error) {
+	var b bytes.Buffer
+
+	b.WriteByte('{')
+
+	for i, f := range o {
+		if i > 0 {
+			b.WriteByte(',')
+		}
+
+		key, err := json.Marshal(f.Key)
+		if err != nil {
+			return nil, err
+		}
+
+		b.Write(key)
+		b.WriteByte(':')
+		b.Write(f.Value)
+	}
+
+	b.WriteByte('}')
+
+	return b.Bytes(), nil
+}
+
+// selectJSONFields reduces v (an object or a list of objects) to the
+// top-level keys named by fields, in the order given.
+func selectJSONFields(v any, fields []string) (any, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+
+	var list []map[string]json.RawMessage
+	if err := json.Unmarshal(data, &list); err == nil {
+		out := make([]orderedObject, len(list))
+
+		for i, obj := range list {
+			if out[i], err = pickJSONFields(obj, fields); err != nil {
+				return nil, err
+			}
+		}
+
+		return out, nil
+	}
+
+	var obj map[string]json.RawMessage
+	if err := json.Unmarshal(data, &obj); err != nil {
+		return nil, fmt.Errorf("--fields needs object output, got %s", bytes.TrimSpace(data[:min(len(data), 20)]))
+	}
+
+	return pickJSONFields(obj, fields)
+}
+
+func pickJSONFields(obj map[string]json.RawMessage, fields []string) (orderedObject, error) {
+	out := make(orderedObject, 0, len(fields))
+
+	for _, field := range fields {
+		if val, ok := obj[field]; ok {
+			out = append(out, orderedField{Key: field, Value: val})
+
+			continue
+		}
+
+		found := false
+
+		for key, val := range obj {
+			if fieldKey(key) == fieldKey(field) {
+				out = append(out, orderedField{Key: key, Value: val})
+				found = true
+
+				break
+			}
+		}
+
+		if !found {
+			keys := make([]string, 0, len(obj))
+			for k := range obj {
+				keys = append(keys, k)
+			}
+
+			sort.Strings(keys)
+
+			return nil, unknownField(field, keys)
+		}
+	}
+
+	return out, nil
+}
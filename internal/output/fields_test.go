@@ -0,0 +1,114 @@
+package output
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestFormatterFields(t *testing.T) {
+	type option struct {
+		ID        string `json:"id"`
+		Value     string `json:"value"`
+		VoteCount int    `json:"vote_count"`
+	}
+
+	opts := []option{{ID: "o1", Value: "Pizza", VoteCount: 3}, {ID: "o2", Value: "Sushi", VoteCount: 1}}
+	headers := []string{"ID", "Option", "Votes"}
+	rows := [][]string{{"o1", "Pizza", "3"}, {"o2", "Sushi", "1"}}
+
+	tests := []struct {
+		name   string
+		mode   Mode
+		fields []string
+		want   string
+	}{
+		{
+			name:   "csv selects and reorders columns",
+			mode:   ModeCSV,
+			fields: []string{"votes", "option"},
+			want:   "Votes,Option\n3,Pizza\n1,Sushi\n",
+		},
+		{
+			name:   "ndjson selects keys",
+			mode:   ModeNDJSON,
+			fields: []string{"voteCount", "id"},
+			want:   "{\"vote_count\":3,\"id\":\"o1\"}\n{\"vote_count\":1,\"id\":\"o2\"}\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+
+			f := &Formatter{Writer: &buf, Mode: tt.mode, Colors: &Colors{}, Fields: tt.fields}
+			if err := f.Output(opts, headers, rows); err != nil {
+				t.Fatalf("Output: %v", err)
+			}
+
+			if buf.String() != tt.want {
+				t.Errorf("got %q, want %q", buf.String(), tt.want)
+			}
+		})
+	}
+}
+
+func TestFormatterFields_Single(t *testing.T) {
+	var buf bytes.Buffer
+
+	f := &Formatter{Writer: &buf, Mode: ModePlain, Colors: &Colors{}, Fields: []string{"title"}}
+
+	err := f.OutputSingle(nil, [][2]string{{"ID", "abc"}, {"Title", "Lunch"}})
+	if err != nil {
+		t.Fatalf("OutputSingle: %v", err)
+	}
+
+	if buf.String() != "Title\nLunch\n" {
+		t.Errorf("got %q", buf.String())
+	}
+}
+
+func TestFormatterFields_Unknown(t *testing.T) {
+	f := &Formatter{Writer: &bytes.Buffer{}, Mode: ModeTable, Colors: &Colors{}, Fields: []string{"nope"}}
+
+	if err := f.Output(nil, []string{"ID"}, nil); err == nil {
+		t.Error("expected unknown field error")
+	}
+
+	f.Mode = ModeJSON
+	if err := f.Output(map[string]int{"id": 1}, nil, nil); err == nil {
+		t.Error("expected unknown key error")
+	}
+}
+
+type testPage struct {
+	Data  []map[string]string `json:"data"`
+	Total int                 `json:"total"`
+}
+
+func (p *testPage) Items() any { return p.Data }
+
+func TestFormatterLister(t *testing.T) {
+	page := &testPage{Data: []map[string]string{{"id": "a"}, {"id": "b"}}, Total: 2}
+
+	var buf bytes.Buffer
+
+	f := &Formatter{Writer: &buf, Mode: ModeNDJSON}
+	if err := f.Output(page, nil, nil); err != nil {
+		t.Fatalf("Output: %v", err)
+	}
+
+	if buf.String() != "{\"id\":\"a\"}\n{\"id\":\"b\"}\n" {
+		t.Errorf("ndjson = %q", buf.String())
+	}
+
+	buf.Reset()
+	f.Mode = ModeJSON
+
+	if err := f.Output(page, nil, nil); err != nil {
+		t.Fatalf("Output: %v", err)
+	}
+
+	if !bytes.Contains(buf.Bytes(), []byte(`"total": 2`)) {
+		t.Errorf("json should keep the envelope: %s", buf.String())
+	}
+}
@@ -5,7 +5,9 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"reflect"
 	"strings"
+	"text/template"
 )
 
 // Mode represents the output format mode.
@@ -18,18 +20,60 @@ const (
 	ModeJSON
 	// ModePlain outputs tab-separated values.
 	ModePlain
+	// ModeCSV outputs RFC 4180 comma-separated values.
+	ModeCSV
+	// ModeYAML outputs YAML.
+	ModeYAML
+	// ModeNDJSON outputs one compact JSON value per line.
+	ModeNDJSON
+	// ModeMarkdown outputs GitHub-flavored Markdown tables.
+	ModeMarkdown
 )
 
+var modeNames = map[Mode]string{
+	ModeTable:    "table",
+	ModeJSON:     "json",
+	ModePlain:    "plain",
+	ModeCSV:      "csv",
+	ModeYAML:     "yaml",
+	ModeNDJSON:   "ndjson",
+	ModeMarkdown: "markdown",
+}
+
+// ModeNames lists the accepted --output values in display order.
+var ModeNames = []string{"table", "json", "plain", "csv", "yaml", "ndjson", "markdown"}
+
 // String returns the string representation of the mode.
 func (m Mode) String() string {
-	switch m {
-	case ModeJSON:
-		return "json"
-	case ModePlain:
-		return "plain"
-	default:
-		return "table"
+	if name, ok := modeNames[m]; ok {
+		return name
 	}
+
+	return "table"
+}
+
+// Structured reports whether the mode encodes the raw value (JSON, YAML,
+// NDJSON) rather than rendering headers and rows.
+func (m Mode) Structured() bool {
+	return m == ModeJSON || m == ModeYAML || m == ModeNDJSON
+}
+
+// ParseMode parses an --output value.
+func ParseMode(s string) (Mode, error) {
+	switch strings.ToLower(s) {
+	case "md":
+		return ModeMarkdown, nil
+	case "yml":
+		return ModeYAML, nil
+	}
+
+	for m, name := range modeNames {
+		if strings.EqualFold(s, name) {
+			return m, nil
+		}
+	}
+
+	return ModeTable, fmt.Errorf("unknown output format %q (want %s)", s, strings.Join(ModeNames, ", "))
 }
 
 // ModeFromFlags returns the output mode based on command flags.
@@ -49,46 +93,156 @@ type Formatter struct {
 	Writer io.Writer
 	Mode   Mode
 	Colors *Colors
+	// Template, when set, replaces the mode: it is executed against the
+	// raw value, once per element for slices.
+	Template *template.Template
+	// Fields selects and orders columns (tabular modes) or top-level keys
+	// (structured modes).
+	Fields []string
+	// Table controls width fitting in table mode.
+	Table TableOptions
+	// Accessible replaces tables with one sentence per row in table mode,
+	// for screen readers.
+	Accessible bool
+}
+
+// Lister is implemented by paginated responses. Templates, NDJSON and
+// --fields in structured modes operate on the items instead of the envelope.
+type Lister interface {
+	Items() any
+}
+
+// UsesValue reports whether output is rendered from the raw value passed
+// to Output rather than from headers and rows.
+func (f *Formatter) UsesValue() bool {
+	return f.Template != nil || f.Mode.Structured()
 }
 
 // NewFormatter creates a formatter with the given settings.
 func NewFormatter(w io.Writer, jsonFlag, plainFlag, noColor bool) *Formatter {
+	return NewFormatterMode(w, ModeFromFlags(jsonFlag, plainFlag), noColor)
+}
+
+// NewFormatterMode creates a formatter for an explicit output mode.
+func NewFormatterMode(w io.Writer, mode Mode, noColor bool) *Formatter {
 	return &Formatter{
 		Writer: w,
-		Mode:   ModeFromFlags(jsonFlag, plainFlag),
+		Mode:   mode,
 		Colors: NewColors(noColor),
 	}
 }
 
 // Output writes data in the appropriate format.
-// For JSON mode, v is encoded directly.
-// For table/plain modes, headers and rows are used.
+// For structured modes (JSON, YAML, NDJSON), v is encoded directly.
+// For table, plain, CSV and Markdown modes, headers and rows are used.
 func (f *Formatter) Output(v any, headers []string, rows [][]string) error {
+	if l, ok := v.(Lister); ok && (f.Template != nil || f.Mode == ModeNDJSON || len(f.Fields) > 0) {
+		v = l.Items()
+	}
+
+	if f.Template != nil {
+		return writeTemplate(f.Writer, f.Template, v)
+	}
+
+	if len(f.Fields) > 0 {
+		var err error
+
+		if f.Mode.Structured() {
+			v, err = selectJSONFields(v, f.Fields)
+		} else {
+			headers, rows, err = selectColumns(headers, rows, f.Fields)
+		}
+
+		if err != nil {
+			return err
+		}
+	}
+
 	switch f.Mode {
 	case ModeJSON:
 		return WriteJSON(f.Writer, v)
+	case ModeYAML:
+		return WriteYAML(f.Writer, v)
+	case ModeNDJSON:
+		return WriteNDJSON(f.Writer, v)
 	case ModePlain:
 		return WriteTSV(f.Writer, headers, rows)
+	case ModeCSV:
+		return WriteCSV(f.Writer, headers, rows)
+	case ModeMarkdown:
+		return WriteMarkdown(f.Writer, headers, rows)
 	default:
-		return RenderTable(f.Writer, headers, rows, f.Colors)
+		if f.Accessible {
+			return WriteSentences(f.Writer, headers, rows)
+		}
+
+		return renderFitted(f.Writer, headers, rows, f.Colors, f.Table)
 	}
 }
 
+// OutputGrid is Output for matrix-shaped tables such as participant grids.
+// In table mode, a grid too wide for the terminal is transposed when that
+// fits, with corner as the new first header; otherwise columns are paginated.
+func (f *Formatter) OutputGrid(v any, headers []string, rows [][]string, corner string) error {
+	if f.Mode == ModeTable && !f.Accessible && f.Template == nil && len(f.Fields) == 0 && fitsTransposed(headers, rows, f.Table) {
+		headers, rows = Transpose(headers, rows, corner)
+	}
+
+	return f.Output(v, headers, rows)
+}
+
 // OutputSingle writes a single resource as key-value pairs (table mode),
-// or delegates to JSON/TSV for other modes.
+// or delegates to the structured and tabular writers for other modes.
+// Tabular modes get a single row keyed by the pair names; Markdown gets a
+// two-column Field/Value table.
 func (f *Formatter) OutputSingle(v any, kvPairs [][2]string) error {
+	if f.Template != nil {
+		return writeTemplate(f.Writer, f.Template, v)
+	}
+
+	if len(f.Fields) > 0 {
+		var err error
+
+		if f.Mode.Structured() {
+			v, err = selectJSONFields(v, f.Fields)
+		} else {
+			kvPairs, err = selectPairs(kvPairs, f.Fields)
+		}
+
+		if err != nil {
+			return err
+		}
+	}
+
 	switch f.Mode {
 	case ModeJSON:
 		return WriteJSON(f.Writer, v)
-	case ModePlain:
+	case ModeYAML:
+		return WriteYAML(f.Writer, v)
+	case ModeNDJSON:
+		return WriteNDJSON(f.Writer, v)
+	case ModePlain, ModeCSV:
 		headers := make([]string, len(kvPairs))
 		row := make([]string, len(kvPairs))
 		for i, kv := range kvPairs {
 			headers[i] = kv[0]
 			row[i] = kv[1]
 		}
+		if f.Mode == ModeCSV {
+			return WriteCSV(f.Writer, headers, [][]string{row})
+		}
 		return WriteTSV(f.Writer, headers, [][]string{row})
+	case ModeMarkdown:
+		rows := make([][]string, len(kvPairs))
+		for i, kv := range kvPairs {
+			rows[i] = []string{kv[0], kv[1]}
+		}
+		return WriteMarkdown(f.Writer, []string{"Field", "Value"}, rows)
 	default:
+		if f.Accessible {
+			return writeKVSentences(f.Writer, kvPairs)
+		}
+
 		return WriteKV(f.Writer, kvPairs, f.Colors)
 	}
 }
@@ -123,6 +277,30 @@ func WriteJSON(w io.Writer, v any) error {
 	return enc.Encode(v)
 }
 
+// WriteNDJSON writes v as newline-delimited JSON: one compact line per
+// element when v is a slice or array, otherwise a single line.
+func WriteNDJSON(w io.Writer, v any) error {
+	enc := json.NewEncoder(w)
+	enc.SetEscapeHTML(false)
+
+	rv := reflect.ValueOf(v)
+	for rv.Kind() == reflect.Pointer && !rv.IsNil() {
+		rv = rv.Elem()
+	}
+
+	if rv.Kind() != reflect.Slice && rv.Kind() != reflect.Array {
+		return enc.Encode(v)
+	}
+
+	for i := range rv.Len() {
+		if err := enc.Encode(rv.Index(i).Interface()); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 // WriteTSV writes rows as tab-separated values.
 // Headers are written as the first row if non-empty.
 func WriteTSV(w io.Writer, headers []string, rows [][]string) error {
@@ -38,6 +38,10 @@ func TestModeString(t *testing.T) {
 		{ModeTable, "table"},
 		{ModeJSON, "json"},
 		{ModePlain, "plain"},
+		{ModeCSV, "csv"},
+		{ModeYAML, "yaml"},
+		{ModeNDJSON, "ndjson"},
+		{ModeMarkdown, "markdown"},
 	}
 
 	for _, tt := range tests {
@@ -49,6 +53,35 @@ func TestModeString(t *testing.T) {
 	}
 }
 
+func TestParseMode(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    Mode
+		wantErr bool
+	}{
+		{in: "table", want: ModeTable},
+		{in: "JSON", want: ModeJSON},
+		{in: "csv", want: ModeCSV},
+		{in: "yml", want: ModeYAML},
+		{in: "ndjson", want: ModeNDJSON},
+		{in: "md", want: ModeMarkdown},
+		{in: "xml", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			got, err := ParseMode(tt.in)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseMode(%q) err = %v, wantErr %v", tt.in, err, tt.wantErr)
+			}
+
+			if !tt.wantErr && got != tt.want {
+				t.Errorf("ParseMode(%q) = %v, want %v", tt.in, got, tt.want)
+			}
+		})
+	}
+}
+
 func TestWriteJSON(t *testing.T) {
 	t.Run("produces valid indented JSON", func(t *testing.T) {
 		data := map[string]any{
@@ -0,0 +1,111 @@
+package output
+
+import (
+	"bytes"
+	"flag"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+var update = flag.Bool("update", false, "rewrite golden files")
+
+type goldenOption struct {
+	Value     string `json:"value"`
+	VoteCount int    `json:"vote_count"`
+}
+
+type goldenPoll struct {
+	ID      string          `json:"id"`
+	Title   string          `json:"title"`
+	Options []*goldenOption `json:"poll_options"`
+}
+
+// goldenData includes commas, quotes, tabs, newlines and pipes so every
+// format's escaping is exercised.
+func goldenData() (*goldenPoll, []string, [][]string, [][2]string) {
+	poll := &goldenPoll{
+		ID:    "NPgxkzPqrn2",
+		Title: "Team lunch",
+		Options: []*goldenOption{
+			{Value: "Pizza, \"large\"", VoteCount: 3},
+			{Value: "Sushi\tbar", VoteCount: 2},
+			{Value: "Tacos\nor burritos | both", VoteCount: 0},
+		},
+	}
+
+	headers := []string{"Option", "Votes", "Percentage"}
+	rows := [][]string{
+		{"Pizza, \"large\"", "3", "60.0%"},
+		{"Sushi\tbar", "2", "40.0%"},
+		{"Tacos\nor burritos | both", "0", "0.0%"},
+	}
+
+	kv := [][2]string{
+		{"ID", poll.ID},
+		{"Title", poll.Title},
+		{"Options", "3"},
+	}
+
+	return poll, headers, rows, kv
+}
+
+func TestFormatterGolden(t *testing.T) {
+	poll, headers, rows, kv := goldenData()
+
+	for _, name := range ModeNames {
+		mode, err := ParseMode(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		t.Run(name, func(t *testing.T) {
+			f := &Formatter{Mode: mode, Colors: &Colors{enabled: false}}
+
+			var list bytes.Buffer
+
+			f.Writer = &list
+			if err := f.Output(poll.Options, headers, rows); err != nil {
+				t.Fatalf("Output: %v", err)
+			}
+
+			checkGolden(t, "output."+name+".golden", list.Bytes())
+
+			var single bytes.Buffer
+
+			f.Writer = &single
+			if err := f.OutputSingle(poll, kv); err != nil {
+				t.Fatalf("OutputSingle: %v", err)
+			}
+
+			checkGolden(t, "single."+name+".golden", single.Bytes())
+		})
+	}
+}
+
+func checkGolden(t *testing.T, name string, got []byte) {
+	t.Helper()
+
+	path := filepath.Join("testdata", name)
+
+	if *update {
+		if err := os.MkdirAll("testdata", 0o755); err != nil {
+			t.Fatal(err)
+		}
+
+		if err := os.WriteFile(path, got, 0o644); err != nil {
+			t.Fatal(err)
+		}
+
+		return
+	}
+
+	want, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("read golden file (run go test -update to create): %v", err)
+	}
+
+	if !bytes.Equal(got, want) {
+		t.Errorf("%s mismatch\n--- got ---\n%s\n--- want ---\n%s", name, got, want)
+	}
+}
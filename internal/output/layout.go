@@ -0,0 +1,325 @@
+package output
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+const (
+	// columnGap is the padding rendered after every table column.
+	columnGap = 2
+	// minColumnWidth is the narrowest a column is shrunk to before a table
+	// is split into column pages.
+	minColumnWidth = 6
+)
+
+// TableOptions controls how tables adapt to the terminal.
+type TableOptions struct {
+	// Width is the available line width; 0 disables fitting (--wide or
+	// output that is not a terminal).
+	Width int
+	// MaxColWidth caps every column; 0 means no cap.
+	MaxColWidth int
+	// Wrap wraps long cells onto several lines instead of truncating them.
+	Wrap bool
+}
+
+// naturalWidths returns the display width of the widest cell per column,
+// capped at maxCol when set.
+func naturalWidths(headers []string, rows [][]string, maxCol int) []int {
+	n := len(headers)
+	for _, row := range rows {
+		n = max(n, len(row))
+	}
+
+	widths := make([]int, n)
+
+	measure := func(cells []string) {
+		for i, c := range cells {
+			for _, line := range strings.Split(c, "\n") {
+				widths[i] = max(widths[i], lipgloss.Width(line))
+			}
+		}
+	}
+
+	measure(headers)
+
+	for _, row := range rows {
+		measure(row)
+	}
+
+	if maxCol > 0 {
+		for i := range widths {
+			widths[i] = min(widths[i], maxCol)
+		}
+	}
+
+	return widths
+}
+
+// tableWidth is the rendered line width for the given column widths.
+func tableWidth(widths []int) int {
+	total := 0
+	for _, w := range widths {
+		total += w + columnGap
+	}
+
+	return total
+}
+
+// fitWidths shrinks the widest columns until the table fits in width or
+// every column is at its minimum. It reports whether the result fits.
+func fitWidths(widths []int, width int) ([]int, bool) {
+	fitted := append([]int(nil), widths...)
+	if width <= 0 {
+		return fitted, true
+	}
+
+	for tableWidth(fitted) > width {
+		widest := -1
+
+		for i, w := range fitted {
+			if w > minColumnWidth && (widest < 0 || w > fitted[widest]) {
+				widest = i
+			}
+		}
+
+		if widest < 0 {
+			return fitted, false
+		}
+
+		fitted[widest]--
+	}
+
+	return fitted, true
+}
+
+// fitCells truncates or wraps every cell to its column width.
+func fitCells(cells []string, widths []int, wrap bool) []string {
+	out := make([]string, len(cells))
+
+	for i, c := range cells {
+		if i >= len(widths) || lipgloss.Width(c) <= widths[i] && !strings.Contains(c, "\n") {
+			out[i] = c
+
+			continue
+		}
+
+		if wrap {
+			out[i] = strings.Join(WrapText(c, widths[i]), "\n")
+		} else {
+			out[i] = Truncate(strings.ReplaceAll(c, "\n", " "), widths[i])
+		}
+	}
+
+	return out
+}
+
+// WrapText breaks s into lines of at most width display columns, splitting
+// on spaces and hard-breaking words that are longer than a line.
+func WrapText(s string, width int) []string {
+	if width <= 0 {
+		return []string{s}
+	}
+
+	var lines []string
+
+	for _, para := range strings.Split(s, "\n") {
+		line := ""
+
+		for _, word := range strings.Fields(para) {
+			for lipgloss.Width(word) > width {
+				if line != "" {
+					lines = append(lines, line)
+					line = ""
+				}
+
+				head, rest := splitAtWidth(word, width)
+				lines = append(lines, head)
+				word = rest
+			}
+
+			switch {
+			case line == "":
+				line = word
+			case lipgloss.Width(line)+1+lipgloss.Width(word) <= width:
+				line += " " + word
+			default:
+				lines = append(lines, line)
+				line = word
+			}
+		}
+
+		lines = append(lines, line)
+	}
+
+	return lines
+}
+
+// splitAtWidth splits s after the longest prefix that fits in width columns.
+func splitAtWidth(s string, width int) (string, string) {
+	r := []rune(s)
+
+	n := 0
+	for n < len(r) && lipgloss.Width(string(r[:n+1])) <= width {
+		n++
+	}
+
+	n = max(n, 1) // always make progress, even for a wide rune
+
+	return string(r[:n]), string(r[n:])
+}
+
+// columnPages splits the columns after the first into groups that fit in
+// width alongside the first column, which is repeated on every page.
+func columnPages(widths []int, width int) [][]int {
+	if len(widths) <= 1 {
+		return [][]int{{0}}
+	}
+
+	var pages [][]int
+
+	page := []int{0}
+	used := widths[0] + columnGap
+
+	for i := 1; i < len(widths); i++ {
+		w := min(widths[i], max(width-widths[0]-2*columnGap, minColumnWidth)) + columnGap
+		if len(page) > 1 && used+w > width {
+			pages = append(pages, page)
+			page = []int{0}
+			used = widths[0] + columnGap
+		}
+
+		page = append(page, i)
+		used += w
+	}
+
+	return append(pages, page)
+}
+
+// pickIndexes returns the elements of s at the given indexes.
+func pickIndexes[T any](s []T, idx []int) []T {
+	out := make([]T, len(idx))
+	for i, j := range idx {
+		if j < len(s) {
+			out[i] = s[j]
+		}
+	}
+
+	return out
+}
+
+// renderFitted renders a table within opts.Width, shrinking columns first
+// and paginating columns when the table cannot fit even at minimum widths.
+func renderFitted(w io.Writer, headers []string, rows [][]string, colors *Colors, opts TableOptions) error {
+	if opts.Width <= 0 && opts.MaxColWidth <= 0 {
+		return RenderTable(w, headers, rows, colors)
+	}
+
+	natural := naturalWidths(headers, rows, opts.MaxColWidth)
+
+	widths, fits := fitWidths(natural, opts.Width)
+	if fits {
+		return renderCells(w, headers, rows, widths, colors, opts.Wrap)
+	}
+
+	pages := columnPages(natural, opts.Width)
+	if len(pages) == 1 && len(pages[0]) == 1 {
+		return renderCells(w, headers, rows, widths, colors, opts.Wrap)
+	}
+
+	for n, cols := range pages {
+		if n > 0 {
+			if _, err := fmt.Fprintln(w); err != nil {
+				return err
+			}
+		}
+
+		caption := fmt.Sprintf("Columns %d-%d of %d", cols[1]+1, cols[len(cols)-1]+1, len(natural))
+		if colors != nil {
+			caption = colors.Dim(caption)
+		}
+
+		if _, err := fmt.Fprintln(w, caption); err != nil {
+			return err
+		}
+
+		pageRows := make([][]string, len(rows))
+		for i, row := range rows {
+			pageRows[i] = pickIndexes(row, cols)
+		}
+
+		pageWidths, _ := fitWidths(pickIndexes(natural, cols), opts.Width)
+		if err := renderCells(w, pickIndexes(headers, cols), pageRows, pageWidths, colors, opts.Wrap); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// renderCells fits every cell to widths and renders the table.
+func renderCells(w io.Writer, headers []string, rows [][]string, widths []int, colors *Colors, wrap bool) error {
+	fittedHeaders := fitCells(headers, widths, false)
+	fittedRows := make([][]string, len(rows))
+
+	for i, row := range rows {
+		fittedRows[i] = fitCells(row, widths, wrap)
+	}
+
+	return RenderTable(w, fittedHeaders, fittedRows, colors)
+}
+
+// fitsTransposed reports whether a table that overflows opts.Width as
+// given would fit once transposed.
+func fitsTransposed(headers []string, rows [][]string, opts TableOptions) bool {
+	if opts.Width <= 0 {
+		return false
+	}
+
+	if _, fits := fitWidths(naturalWidths(headers, rows, opts.MaxColWidth), opts.Width); fits {
+		return false
+	}
+
+	th, tr := Transpose(headers, rows, "")
+	_, fits := fitWidths(naturalWidths(th, tr, opts.MaxColWidth), opts.Width)
+
+	return fits
+}
+
+// Transpose swaps rows and columns. The first header becomes corner, and
+// each original header becomes the first cell of a row.
+func Transpose(headers []string, rows [][]string, corner string) ([]string, [][]string) {
+	out := make([]string, 0, 1+len(rows))
+	out = append(out, corner)
+
+	for _, row := range rows {
+		if len(row) > 0 {
+			out = append(out, row[0])
+		} else {
+			out = append(out, "")
+		}
+	}
+
+	transposed := make([][]string, 0, max(len(headers)-1, 0))
+
+	for j := 1; j < len(headers); j++ {
+		row := make([]string, 0, 1+len(rows))
+		row = append(row, headers[j])
+
+		for _, r := range rows {
+			if j < len(r) {
+				row = append(row, r[j])
+			} else {
+				row = append(row, "")
+			}
+		}
+
+		transposed = append(transposed, row)
+	}
+
+	return out, transposed
+}
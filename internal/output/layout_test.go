@@ -0,0 +1,191 @@
+package output
+
+import (
+	"bytes"
+	"fmt"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+func maxLineWidth(s string) int {
+	widest := 0
+	for _, line := range strings.Split(strings.TrimRight(s, "\n"), "\n") {
+		widest = max(widest, lipgloss.Width(strings.TrimRight(line, " ")))
+	}
+
+	return widest
+}
+
+func TestFitWidths(t *testing.T) {
+	tests := []struct {
+		name   string
+		in     []int
+		width  int
+		want   []int
+		wantOK bool
+	}{
+		{"no limit", []int{30, 5}, 0, []int{30, 5}, true},
+		{"already fits", []int{10, 5}, 40, []int{10, 5}, true},
+		{"shrinks widest first", []int{40, 12, 5}, 40, []int{17, 12, 5}, true},
+		{"stops at minimum", []int{20, 20, 20}, 10, []int{minColumnWidth, minColumnWidth, minColumnWidth}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := fitWidths(tt.in, tt.width)
+			if !slices.Equal(got, tt.want) || ok != tt.wantOK {
+				t.Errorf("fitWidths(%v, %d) = %v, %v; want %v, %v", tt.in, tt.width, got, ok, tt.want, tt.wantOK)
+			}
+		})
+	}
+}
+
+func TestWrapText(t *testing.T) {
+	tests := []struct {
+		in    string
+		width int
+		want  []string
+	}{
+		{"short", 10, []string{"short"}},
+		{"the quick brown fox", 10, []string{"the quick", "brown fox"}},
+		{"abcdefghijkl", 5, []string{"abcde", "fghij", "kl"}},
+		{"abcdef", 5, []string{"abcde", "f"}},
+		{"one\ntwo", 10, []string{"one", "two"}},
+	}
+
+	for _, tt := range tests {
+		if got := WrapText(tt.in, tt.width); !slices.Equal(got, tt.want) {
+			t.Errorf("WrapText(%q, %d) = %q, want %q", tt.in, tt.width, got, tt.want)
+		}
+	}
+}
+
+func TestOutput_NarrowTruncates(t *testing.T) {
+	headers := []string{"Option", "Votes", "Percentage"}
+	rows := [][]string{
+		{"A very long option text that would never fit in a narrow terminal", "3", "60.0%"},
+		{"Short", "2", "40.0%"},
+	}
+
+	for _, width := range []int{30, 40, 60} {
+		t.Run(fmt.Sprint(width), func(t *testing.T) {
+			var buf bytes.Buffer
+
+			f := &Formatter{Writer: &buf, Colors: &Colors{}, Table: TableOptions{Width: width}}
+			if err := f.Output(nil, headers, rows); err != nil {
+				t.Fatalf("Output: %v", err)
+			}
+
+			if got := maxLineWidth(buf.String()); got > width {
+				t.Errorf("line width %d exceeds %d:\n%s", got, width, buf.String())
+			}
+
+			if !strings.Contains(buf.String(), "…") || !strings.Contains(buf.String(), "60.0%") {
+				t.Errorf("expected truncated option and intact numbers:\n%s", buf.String())
+			}
+		})
+	}
+}
+
+func TestOutput_NarrowWraps(t *testing.T) {
+	rows := [][]string{{"the quick brown fox jumps over the lazy dog", "1"}}
+
+	var buf bytes.Buffer
+
+	f := &Formatter{Writer: &buf, Colors: &Colors{}, Table: TableOptions{Width: 24, Wrap: true}}
+	if err := f.Output(nil, []string{"Option", "Votes"}, rows); err != nil {
+		t.Fatalf("Output: %v", err)
+	}
+
+	out := buf.String()
+	if strings.Contains(out, "…") {
+		t.Errorf("wrap mode should not truncate:\n%s", out)
+	}
+
+	if !strings.Contains(out, "lazy dog") || maxLineWidth(out) > 24 {
+		t.Errorf("expected wrapped text within 24 columns:\n%s", out)
+	}
+}
+
+func TestOutput_MaxColWidthWithoutTerminal(t *testing.T) {
+	var buf bytes.Buffer
+
+	f := &Formatter{Writer: &buf, Colors: &Colors{}, Table: TableOptions{MaxColWidth: 8}}
+	if err := f.Output(nil, []string{"Option"}, [][]string{{"Extraordinarily long"}}); err != nil {
+		t.Fatalf("Output: %v", err)
+	}
+
+	if !strings.Contains(buf.String(), "Extraor…") {
+		t.Errorf("expected cell capped at 8 columns:\n%s", buf.String())
+	}
+}
+
+func TestOutput_PaginatesWideTables(t *testing.T) {
+	headers := []string{"Slot"}
+	row := []string{"Mon Jan 2 10:00-11:00"}
+
+	for i := range 12 {
+		headers = append(headers, fmt.Sprintf("Participant%02d", i))
+		row = append(row, "Maybe")
+	}
+
+	var buf bytes.Buffer
+
+	f := &Formatter{Writer: &buf, Colors: &Colors{}, Table: TableOptions{Width: 40}}
+	if err := f.Output(nil, headers, [][]string{row}); err != nil {
+		t.Fatalf("Output: %v", err)
+	}
+
+	out := buf.String()
+	pages := strings.Count(out, "Columns ")
+
+	if pages < 2 {
+		t.Fatalf("expected several column pages:\n%s", out)
+	}
+
+	if strings.Count(out, "Mon Jan 2") != pages {
+		t.Errorf("first column should repeat on every page:\n%s", out)
+	}
+
+	if got := maxLineWidth(out); got > 40 {
+		t.Errorf("line width %d exceeds 40:\n%s", got, out)
+	}
+}
+
+func TestOutputGrid_Transposes(t *testing.T) {
+	headers := []string{"Slot", "Alice", "Bob", "Carol", "Dave", "Erin", "Frank", "Grace", "Heidi", "Total"}
+	rows := [][]string{
+		{"Mon", "Yes", "No", "Yes", "Yes", "No", "Yes", "Maybe", "Yes", "6+1/8"},
+		{"Tue", "No", "No", "Yes", "No", "No", "Yes", "Yes", "Yes", "4/8"},
+	}
+
+	var buf bytes.Buffer
+
+	f := &Formatter{Writer: &buf, Colors: &Colors{}, Table: TableOptions{Width: 40}}
+	if err := f.OutputGrid(nil, headers, rows, "Name"); err != nil {
+		t.Fatalf("OutputGrid: %v", err)
+	}
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	if !strings.HasPrefix(lines[0], "Name") || !strings.Contains(lines[0], "Tue") {
+		t.Errorf("expected transposed header, got %q", lines[0])
+	}
+
+	if len(lines) != 2+len(headers)-1 {
+		t.Errorf("expected one row per participant plus total, got %d lines:\n%s", len(lines), buf.String())
+	}
+
+	buf.Reset()
+	f.Table.Width = 0
+
+	if err := f.OutputGrid(nil, headers, rows, "Name"); err != nil {
+		t.Fatal(err)
+	}
+
+	if !strings.HasPrefix(buf.String(), "Slot") {
+		t.Errorf("without a width limit the grid should not transpose:\n%s", buf.String())
+	}
+}
@@ -0,0 +1,120 @@
+package output
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"strings"
+)
+
+// DefaultPager is used when no pager is configured. -F quits when the
+// output fits one screen, -R passes colors through and -X keeps the
+// output on screen after quitting.
+const DefaultPager = "less -FRX"
+
+// PagerCommand resolves the pager command: $STRAWPOLL_PAGER, then the
+// configured value, then $PAGER, then DefaultPager. An empty result means
+// paging is disabled, which is also the case for "cat".
+func PagerCommand(configured string) string {
+	cmd, ok := os.LookupEnv("STRAWPOLL_PAGER")
+	if !ok {
+		cmd = configured
+	}
+
+	if !ok && cmd == "" {
+		cmd = os.Getenv("PAGER")
+	}
+
+	if !ok && cmd == "" {
+		cmd = DefaultPager
+	}
+
+	cmd = strings.TrimSpace(cmd)
+	if cmd == "cat" {
+		return ""
+	}
+
+	return cmd
+}
+
+// Pager buffers output and, on Close, pipes it through Command when it is
+// taller than Height lines. Shorter output, or a Pager without a command
+// or height, is written to the underlying writer unchanged.
+type Pager struct {
+	Command string
+	Height  int
+
+	out io.Writer
+	buf bytes.Buffer
+}
+
+// NewPager returns a Pager writing to out.
+func NewPager(out io.Writer, command string, height int) *Pager {
+	return &Pager{Command: command, Height: height, out: out}
+}
+
+// Write buffers p until Close.
+func (p *Pager) Write(b []byte) (int, error) {
+	return p.buf.Write(b)
+}
+
+// Close flushes the buffered output, through the pager when it does not
+// fit the screen. If the pager cannot be started the output is written
+// directly instead.
+func (p *Pager) Close() error {
+	defer p.buf.Reset()
+
+	if !p.needsPaging() {
+		_, err := p.out.Write(p.buf.Bytes())
+
+		return err
+	}
+
+	args := strings.Fields(p.Command)
+
+	cmd := exec.Command(args[0], args[1:]...) //nolint:gosec // user-configured pager
+	cmd.Stdin = bytes.NewReader(p.buf.Bytes())
+	cmd.Stdout = p.out
+	cmd.Stderr = os.Stderr
+	cmd.Env = pagerEnv()
+
+	if err := cmd.Start(); err != nil {
+		_, err := p.out.Write(p.buf.Bytes())
+
+		return err
+	}
+
+	if err := cmd.Wait(); err != nil {
+		return fmt.Errorf("pager %s: %w", args[0], err)
+	}
+
+	return nil
+}
+
+// needsPaging reports whether the buffer is taller than the screen, leaving
+// a line for the shell prompt.
+func (p *Pager) needsPaging() bool {
+	if strings.TrimSpace(p.Command) == "" || p.Height <= 0 {
+		return false
+	}
+
+	return bytes.Count(p.buf.Bytes(), []byte("\n")) > p.Height-1
+}
+
+// pagerEnv sets LESS and LV so less and lv keep colors when the user has
+// not configured them.
+func pagerEnv() []string {
+	env := os.Environ()
+
+	if _, ok := os.LookupEnv("LESS"); !ok {
+		env = append(env, "LESS=FRX")
+	}
+
+	if _, ok := os.LookupEnv("LV"); !ok {
+		env = append(env, "LV=-c")
+	}
+
+	return env
+}
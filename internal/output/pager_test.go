@@ -0,0 +1,94 @@
+package output
+
+import (
+	"bytes"
+	"os"
+	"os/exec"
+	"strings"
+	"testing"
+)
+
+func TestPagerCommand(t *testing.T) {
+	tests := []struct {
+		name       string
+		env        map[string]string
+		configured string
+		want       string
+	}{
+		{"default", nil, "", DefaultPager},
+		{"PAGER", map[string]string{"PAGER": "more"}, "", "more"},
+		{"config beats PAGER", map[string]string{"PAGER": "more"}, "most", "most"},
+		{"STRAWPOLL_PAGER wins", map[string]string{"PAGER": "more", "STRAWPOLL_PAGER": "lv"}, "most", "lv"},
+		{"empty STRAWPOLL_PAGER disables", map[string]string{"STRAWPOLL_PAGER": ""}, "most", ""},
+		{"cat disables", nil, "cat", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("PAGER", "")
+			t.Setenv("STRAWPOLL_PAGER", "")
+			_ = os.Unsetenv("STRAWPOLL_PAGER") // restored by t.Setenv
+
+			for k, v := range tt.env {
+				t.Setenv(k, v)
+			}
+
+			if got := PagerCommand(tt.configured); got != tt.want {
+				t.Errorf("PagerCommand(%q) = %q, want %q", tt.configured, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPager_ShortOutputPassesThrough(t *testing.T) {
+	var buf bytes.Buffer
+
+	p := NewPager(&buf, "does-not-exist-pager", 10)
+	_, _ = p.Write([]byte("one\ntwo\n"))
+
+	if buf.Len() != 0 {
+		t.Fatal("output should be buffered until Close")
+	}
+
+	if err := p.Close(); err != nil {
+		t.Fatalf("Close: %v", err)
+	}
+
+	if buf.String() != "one\ntwo\n" {
+		t.Errorf("got %q", buf.String())
+	}
+}
+
+func TestPager_LongOutputIsPaged(t *testing.T) {
+	if _, err := exec.LookPath("tr"); err != nil {
+		t.Skip("tr not available")
+	}
+
+	var buf bytes.Buffer
+
+	p := NewPager(&buf, "tr a-z A-Z", 3)
+	_, _ = p.Write([]byte(strings.Repeat("row\n", 5)))
+
+	if err := p.Close(); err != nil {
+		t.Fatalf("Close: %v", err)
+	}
+
+	if buf.String() != strings.Repeat("ROW\n", 5) {
+		t.Errorf("output was not piped through the pager: %q", buf.String())
+	}
+}
+
+func TestPager_MissingCommandFallsBack(t *testing.T) {
+	var buf bytes.Buffer
+
+	p := NewPager(&buf, "does-not-exist-pager -R", 2)
+	_, _ = p.Write([]byte("a\nb\nc\n"))
+
+	if err := p.Close(); err != nil {
+		t.Fatalf("Close: %v", err)
+	}
+
+	if buf.String() != "a\nb\nc\n" {
+		t.Errorf("got %q", buf.String())
+	}
+}
@@ -0,0 +1,196 @@
+package output
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// ExportFormat is a file format for exported reports.
+type ExportFormat string
+
+const (
+	ExportCSV      ExportFormat = "csv"
+	ExportMarkdown ExportFormat = "md"
+	ExportHTML     ExportFormat = "html"
+	ExportXLSX     ExportFormat = "xlsx"
+)
+
+// ExportFormats lists the supported export formats.
+var ExportFormats = []ExportFormat{ExportCSV, ExportMarkdown, ExportHTML, ExportXLSX}
+
+// ParseExportFormat resolves the export format from an explicit name, or
+// from the extension of path when name is empty.
+func ParseExportFormat(name, path string) (ExportFormat, error) {
+	if name == "" {
+		name = strings.TrimPrefix(filepath.Ext(path), ".")
+		if name == "" {
+			return "", fmt.Errorf("cannot infer export format: use --format or an output file with an extension")
+		}
+	}
+
+	switch strings.ToLower(name) {
+	case "csv":
+		return ExportCSV, nil
+	case "md", "markdown":
+		return ExportMarkdown, nil
+	case "html", "htm":
+		return ExportHTML, nil
+	case "xlsx":
+		return ExportXLSX, nil
+	default:
+		return "", fmt.Errorf("unsupported export format %q (want csv, md, html or xlsx)", name)
+	}
+}
+
+// ReportTable is a titled table within a report.
+type ReportTable struct {
+	Title   string
+	Headers []string
+	Rows    [][]string
+}
+
+// Report is a format-independent poll results document.
+type Report struct {
+	Title       string
+	Meta        [][2]string
+	Chart       []Bar
+	ChartTitle  string
+	Tables      []ReportTable
+	GeneratedAt time.Time
+}
+
+// WriteReport writes r to w in the given format.
+func WriteReport(w io.Writer, r *Report, format ExportFormat) error {
+	switch format {
+	case ExportCSV:
+		return writeReportCSV(w, r)
+	case ExportMarkdown:
+		return writeReportMarkdown(w, r)
+	case ExportHTML:
+		return writeReportHTML(w, r)
+	case ExportXLSX:
+		return writeReportXLSX(w, r)
+	default:
+		return fmt.Errorf("unsupported export format %q", format)
+	}
+}
+
+// reportMeta returns the metadata rows followed by the generation timestamp.
+func reportMeta(r *Report) [][2]string {
+	meta := make([][2]string, 0, len(r.Meta)+1)
+	meta = append(meta, r.Meta...)
+
+	return append(meta, [2]string{"Exported", r.GeneratedAt.UTC().Format(time.RFC3339)})
+}
+
+// writeReportCSV writes the metadata and each table as blank-line-separated
+// blocks, which spreadsheets import as a single sheet.
+func writeReportCSV(w io.Writer, r *Report) error {
+	cw := csv.NewWriter(w)
+
+	_ = cw.Write([]string{"Field", "Value"})
+	for _, kv := range reportMeta(r) {
+		_ = cw.Write(kv[:])
+	}
+
+	for _, t := range r.Tables {
+		_ = cw.Write(nil)
+		_ = cw.Write([]string{t.Title})
+		_ = cw.Write(t.Headers)
+
+		for _, row := range t.Rows {
+			_ = cw.Write(row)
+		}
+	}
+
+	cw.Flush()
+
+	return cw.Error()
+}
+
+// WriteCSV writes headers and rows as RFC 4180 CSV.
+func WriteCSV(w io.Writer, headers []string, rows [][]string) error {
+	cw := csv.NewWriter(w)
+
+	if len(headers) > 0 {
+		if err := cw.Write(headers); err != nil {
+			return err
+		}
+	}
+
+	for _, row := range rows {
+		if err := cw.Write(row); err != nil {
+			return err
+		}
+	}
+
+	cw.Flush()
+
+	return cw.Error()
+}
+
+func writeReportMarkdown(w io.Writer, r *Report) error {
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "# %s\n\n", markdownEscape(r.Title))
+
+	for _, kv := range reportMeta(r) {
+		fmt.Fprintf(&b, "- **%s:** %s\n", markdownEscape(kv[0]), markdownEscape(kv[1]))
+	}
+
+	for _, t := range r.Tables {
+		fmt.Fprintf(&b, "\n## %s\n\n", markdownEscape(t.Title))
+
+		if err := WriteMarkdown(&b, t.Headers, t.Rows); err != nil {
+			return err
+		}
+	}
+
+	_, err := io.WriteString(w, b.String())
+
+	return err
+}
+
+// WriteMarkdown writes headers and rows as a GitHub-flavored Markdown table.
+func WriteMarkdown(w io.Writer, headers []string, rows [][]string) error {
+	var b strings.Builder
+
+	writeRow := func(cells []string) {
+		b.WriteString("|")
+
+		for _, c := range cells {
+			b.WriteString(" " + markdownEscape(c) + " |")
+		}
+
+		b.WriteString("\n")
+	}
+
+	if len(headers) > 0 {
+		writeRow(headers)
+
+		sep := make([]string, len(headers))
+		for i := range sep {
+			sep[i] = "---"
+		}
+
+		b.WriteString("|" + strings.Join(sep, "|") + "|\n")
+	}
+
+	for _, row := range rows {
+		writeRow(row)
+	}
+
+	_, err := io.WriteString(w, b.String())
+
+	return err
+}
+
+var markdownReplacer = strings.NewReplacer("|", `\|`, "\r\n", " ", "\n", " ")
+
+func markdownEscape(s string) string {
+	return markdownReplacer.Replace(s)
+}
@@ -0,0 +1,124 @@
+package output
+
+import (
+	"html/template"
+	"io"
+)
+
+const (
+	svgWidth      = 640
+	svgLabelWidth = 200
+	svgValueWidth = 110
+	svgRowHeight  = 28
+	svgBarHeight  = 18
+)
+
+type svgBar struct {
+	Y, TextY, Width int
+	Label, Display  string
+	Leader          bool
+}
+
+type htmlReport struct {
+	*Report
+	Meta      [][2]string
+	Bars      []svgBar
+	SVGWidth  int
+	SVGHeight int
+	BarHeight int
+	BarX      int
+	ValueX    int
+}
+
+// writeReportHTML renders a standalone HTML page with inline CSS and an
+// inline SVG bar chart, so the file can be opened or attached anywhere.
+func writeReportHTML(w io.Writer, r *Report) error {
+	data := htmlReport{
+		Report:    r,
+		Meta:      reportMeta(r),
+		SVGWidth:  svgWidth,
+		SVGHeight: len(r.Chart)*svgRowHeight + 8,
+		BarHeight: svgBarHeight,
+		BarX:      svgLabelWidth + 8,
+		ValueX:    svgWidth - svgValueWidth + 8,
+	}
+
+	peak := 0.0
+	for _, b := range r.Chart {
+		peak = max(peak, b.Value)
+	}
+
+	barSpace := float64(svgWidth - svgLabelWidth - svgValueWidth - 8)
+
+	for i, b := range r.Chart {
+		width := 0
+		if peak > 0 {
+			width = int(b.Value / peak * barSpace)
+		}
+
+		y := 4 + i*svgRowHeight
+		data.Bars = append(data.Bars, svgBar{
+			Y:       y,
+			TextY:   y + svgBarHeight - 5,
+			Width:   width,
+			Label:   Truncate(b.Label, 30),
+			Display: b.Display,
+			Leader:  peak > 0 && b.Value == peak,
+		})
+	}
+
+	return reportHTMLTemplate.Execute(w, data)
+}
+
+var reportHTMLTemplate = template.Must(template.New("report").Parse(`<!DOCTYPE html>
+<html lang="en">
+<head>
+<meta charset="utf-8">
+<meta name="viewport" content="width=device-width, initial-scale=1">
+<title>{{.Title}}</title>
+<style>
+body { font-family: -apple-system, "Segoe UI", Helvetica, Arial, sans-serif; color: #1f2937; max-width: 960px; margin: 2rem auto; padding: 0 1rem; }
+h1 { margin-bottom: 0.5rem; }
+dl { display: grid; grid-template-columns: max-content auto; gap: 0.25rem 1rem; }
+dt { font-weight: 600; }
+dd { margin: 0; }
+table { border-collapse: collapse; margin: 1rem 0 2rem; }
+th, td { border: 1px solid #d1d5db; padding: 0.35rem 0.75rem; text-align: left; }
+th { background: #f3f4f6; }
+tr:nth-child(even) td { background: #f9fafb; }
+svg text { font-size: 13px; fill: #1f2937; }
+.bar { fill: #93c5fd; }
+.bar.leader { fill: #2563eb; }
+</style>
+</head>
+<body>
+<h1>{{.Title}}</h1>
+<dl>
+{{- range .Meta}}
+<dt>{{index . 0}}</dt><dd>{{index . 1}}</dd>
+{{- end}}
+</dl>
+{{- if .Bars}}
+<h2>{{.ChartTitle}}</h2>
+<svg xmlns="http://www.w3.org/2000/svg" width="{{.SVGWidth}}" height="{{.SVGHeight}}" role="img" aria-label="{{.ChartTitle}}">
+{{- range .Bars}}
+<text x="0" y="{{.TextY}}">{{.Label}}</text>
+<rect class="bar{{if .Leader}} leader{{end}}" x="{{$.BarX}}" y="{{.Y}}" width="{{.Width}}" height="{{$.BarHeight}}" rx="2"></rect>
+<text x="{{$.ValueX}}" y="{{.TextY}}">{{.Display}}</text>
+{{- end}}
+</svg>
+{{- end}}
+{{- range .Tables}}
+<h2>{{.Title}}</h2>
+<table>
+<thead><tr>{{range .Headers}}<th>{{.}}</th>{{end}}</tr></thead>
+<tbody>
+{{- range .Rows}}
+<tr>{{range .}}<td>{{.}}</td>{{end}}</tr>
+{{- end}}
+</tbody>
+</table>
+{{- end}}
+</body>
+</html>
+`))
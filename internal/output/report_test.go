@@ -0,0 +1,209 @@
+package output
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/csv"
+	"encoding/xml"
+	"io"
+	"strings"
+	"testing"
+	"time"
+)
+
+func testReport() *Report {
+	return &Report{
+		Title: "Lunch <today>",
+		Meta:  [][2]string{{"ID", "abc123"}, {"Votes", "3"}},
+		Chart: []Bar{
+			{Label: "Pizza", Value: 2, Display: "2  66.7%"},
+			{Label: "Sushi", Value: 1, Display: "1  33.3%"},
+		},
+		ChartTitle: "Votes",
+		Tables: []ReportTable{
+			{
+				Title:   "Results",
+				Headers: []string{"Option", "Votes", "Percentage"},
+				Rows:    [][]string{{"Pizza", "2", "66.7%"}, {"Sushi, with \"soy\"", "1", "33.3%"}},
+			},
+			{
+				Title:   "Participants",
+				Headers: []string{"Name", "Pizza", "Sushi"},
+				Rows:    [][]string{{"Ann | Bob", "x", " "}},
+			},
+		},
+		GeneratedAt: time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC),
+	}
+}
+
+func TestParseExportFormat(t *testing.T) {
+	tests := []struct {
+		name, path string
+		want       ExportFormat
+		wantErr    bool
+	}{
+		{name: "csv", want: ExportCSV},
+		{name: "markdown", want: ExportMarkdown},
+		{path: "out/results.HTML", want: ExportHTML},
+		{path: "results.xlsx", want: ExportXLSX},
+		{name: "md", path: "results.csv", want: ExportMarkdown},
+		{path: "results", wantErr: true},
+		{name: "pdf", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name+tt.path, func(t *testing.T) {
+			got, err := ParseExportFormat(tt.name, tt.path)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("err = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if got != tt.want {
+				t.Errorf("format = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestWriteReport_CSV(t *testing.T) {
+	var buf bytes.Buffer
+	if err := WriteReport(&buf, testReport(), ExportCSV); err != nil {
+		t.Fatalf("WriteReport error: %v", err)
+	}
+
+	r := csv.NewReader(&buf)
+	r.FieldsPerRecord = -1
+
+	records, err := r.ReadAll()
+	if err != nil {
+		t.Fatalf("output is not valid CSV: %v", err)
+	}
+
+	found := false
+
+	for _, rec := range records {
+		if len(rec) == 3 && rec[0] == "Sushi, with \"soy\"" {
+			found = true
+		}
+
+		if rec[0] == "Exported" && rec[1] != "2025-06-01T12:00:00Z" {
+			t.Errorf("Exported = %q", rec[1])
+		}
+	}
+
+	if !found {
+		t.Error("quoted option value did not round-trip")
+	}
+}
+
+func TestWriteReport_Markdown(t *testing.T) {
+	var buf bytes.Buffer
+	if err := WriteReport(&buf, testReport(), ExportMarkdown); err != nil {
+		t.Fatalf("WriteReport error: %v", err)
+	}
+
+	out := buf.String()
+
+	for _, want := range []string{
+		"# Lunch <today>\n",
+		"- **Exported:** 2025-06-01T12:00:00Z\n",
+		"## Results\n\n| Option | Votes | Percentage |\n|---|---|---|\n",
+		`| Ann \| Bob | x |`,
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("markdown missing %q:\n%s", want, out)
+		}
+	}
+}
+
+func TestWriteReport_HTML(t *testing.T) {
+	var buf bytes.Buffer
+	if err := WriteReport(&buf, testReport(), ExportHTML); err != nil {
+		t.Fatalf("WriteReport error: %v", err)
+	}
+
+	out := buf.String()
+
+	if strings.Contains(out, "<today>") {
+		t.Error("title was not HTML-escaped")
+	}
+
+	if !strings.Contains(out, "<svg") || strings.Count(out, "<rect") != 2 {
+		t.Error("expected an inline SVG with one bar per option")
+	}
+
+	if strings.Contains(out, "<script") || strings.Contains(out, "<link") {
+		t.Error("HTML export must be self-contained")
+	}
+}
+
+func TestWriteReport_XLSX(t *testing.T) {
+	var buf bytes.Buffer
+	if err := WriteReport(&buf, testReport(), ExportXLSX); err != nil {
+		t.Fatalf("WriteReport error: %v", err)
+	}
+
+	zr, err := zip.NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	if err != nil {
+		t.Fatalf("output is not a zip archive: %v", err)
+	}
+
+	names := make(map[string]bool)
+
+	for _, f := range zr.File {
+		names[f.Name] = true
+
+		rc, err := f.Open()
+		if err != nil {
+			t.Fatalf("open %s: %v", f.Name, err)
+		}
+
+		dec := xml.NewDecoder(rc)
+		for {
+			if _, err := dec.Token(); err == io.EOF {
+				break
+			} else if err != nil {
+				t.Fatalf("%s is not well-formed XML: %v", f.Name, err)
+			}
+		}
+
+		rc.Close()
+	}
+
+	for _, want := range []string{
+		"[Content_Types].xml", "xl/workbook.xml",
+		"xl/worksheets/sheet1.xml", "xl/worksheets/sheet2.xml", "xl/worksheets/sheet3.xml",
+	} {
+		if !names[want] {
+			t.Errorf("archive missing %s", want)
+		}
+	}
+}
+
+func TestXLSXColumn(t *testing.T) {
+	for i, want := range map[int]string{0: "A", 25: "Z", 26: "AA", 27: "AB", 701: "ZZ", 702: "AAA"} {
+		if got := xlsxColumn(i); got != want {
+			t.Errorf("xlsxColumn(%d) = %q, want %q", i, got, want)
+		}
+	}
+}
+
+func TestXLSXSheetName(t *testing.T) {
+	used := map[string]bool{}
+
+	if got := xlsxSheetName("Results", 1, used); got != "Results" {
+		t.Errorf("got %q", got)
+	}
+
+	if got := xlsxSheetName("results", 2, used); got != "results (2)" {
+		t.Errorf("duplicate name = %q", got)
+	}
+
+	if got := xlsxSheetName("a/b:c", 3, used); got != "a_b_c" {
+		t.Errorf("invalid characters = %q", got)
+	}
+
+	if got := xlsxSheetName(strings.Repeat("x", 40), 4, used); len(got) != 31 {
+		t.Errorf("long name length = %d", len(got))
+	}
+}
@@ -0,0 +1,235 @@
+package output
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// xlsxSheet is one worksheet: a header row followed by data rows.
+type xlsxSheet struct {
+	Name    string
+	Headers []string
+	Rows    [][]string
+}
+
+// writeReportXLSX writes a minimal Office Open XML workbook with a Summary
+// sheet for the metadata and one sheet per report table. Cells use inline
+// strings, so no shared string table is needed.
+func writeReportXLSX(w io.Writer, r *Report) error {
+	sheets := []xlsxSheet{{Name: "Summary", Headers: []string{"Field", "Value"}}}
+
+	sheets[0].Rows = append(sheets[0].Rows, []string{"Title", r.Title})
+	for _, kv := range reportMeta(r) {
+		sheets[0].Rows = append(sheets[0].Rows, []string{kv[0], kv[1]})
+	}
+
+	for _, t := range r.Tables {
+		sheets = append(sheets, xlsxSheet{Name: t.Title, Headers: t.Headers, Rows: t.Rows})
+	}
+
+	// Buffer the archive so a failed write never leaves a truncated zip behind
+	// on a writer that cannot be rewound.
+	var buf bytes.Buffer
+
+	zw := zip.NewWriter(&buf)
+
+	files := []struct {
+		name, body string
+	}{
+		{"[Content_Types].xml", xlsxContentTypes(len(sheets))},
+		{"_rels/.rels", xlsxRootRels},
+		{"xl/workbook.xml", xlsxWorkbook(sheets)},
+		{"xl/_rels/workbook.xml.rels", xlsxWorkbookRels(len(sheets))},
+		{"xl/styles.xml", xlsxStyles},
+	}
+
+	for i, s := range sheets {
+		files = append(files, struct{ name, body string }{
+			fmt.Sprintf("xl/worksheets/sheet%d.xml", i+1), xlsxWorksheet(s),
+		})
+	}
+
+	for _, f := range files {
+		fw, err := zw.CreateHeader(&zip.FileHeader{Name: f.name, Method: zip.Deflate, Modified: r.GeneratedAt})
+		if err != nil {
+			return err
+		}
+
+		if _, err := io.WriteString(fw, f.body); err != nil {
+			return err
+		}
+	}
+
+	if err := zw.Close(); err != nil {
+		return err
+	}
+
+	_, err := w.Write(buf.Bytes())
+
+	return err
+}
+
+const xlsxHeader = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>` + "\n"
+
+const xlsxRootRels = xlsxHeader +
+	`<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">` +
+	`<Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/officeDocument" Target="xl/workbook.xml"/>` +
+	`</Relationships>`
+
+// xlsxStyles defines two cell formats: 0 is the default, 1 is bold (headers).
+const xlsxStyles = xlsxHeader +
+	`<styleSheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main">` +
+	`<fonts count="2"><font><sz val="11"/><name val="Calibri"/></font><font><b/><sz val="11"/><name val="Calibri"/></font></fonts>` +
+	`<fills count="2"><fill><patternFill patternType="none"/></fill><fill><patternFill patternType="gray125"/></fill></fills>` +
+	`<borders count="1"><border><left/><right/><top/><bottom/><diagonal/></border></borders>` +
+	`<cellStyleXfs count="1"><xf numFmtId="0" fontId="0" fillId="0" borderId="0"/></cellStyleXfs>` +
+	`<cellXfs count="2"><xf numFmtId="0" fontId="0" fillId="0" borderId="0" xfId="0"/>` +
+	`<xf numFmtId="0" fontId="1" fillId="0" borderId="0" xfId="0" applyFont="1"/></cellXfs>` +
+	`</styleSheet>`
+
+func xlsxContentTypes(n int) string {
+	var b strings.Builder
+
+	b.WriteString(xlsxHeader)
+	b.WriteString(`<Types xmlns="http://schemas.openxmlformats.org/package/2006/content-types">`)
+	b.WriteString(`<Default Extension="rels" ContentType="application/vnd.openxmlformats-package.relationships+xml"/>`)
+	b.WriteString(`<Default Extension="xml" ContentType="application/xml"/>`)
+	b.WriteString(`<Override PartName="/xl/workbook.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.sheet.main+xml"/>`)
+	b.WriteString(`<Override PartName="/xl/styles.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.styles+xml"/>`)
+
+	for i := 1; i <= n; i++ {
+		fmt.Fprintf(&b, `<Override PartName="/xl/worksheets/sheet%d.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.worksheet+xml"/>`, i)
+	}
+
+	b.WriteString(`</Types>`)
+
+	return b.String()
+}
+
+func xlsxWorkbook(sheets []xlsxSheet) string {
+	var b strings.Builder
+
+	b.WriteString(xlsxHeader)
+	b.WriteString(`<workbook xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main" xmlns:r="http://schemas.openxmlformats.org/officeDocument/2006/relationships"><sheets>`)
+
+	used := make(map[string]bool, len(sheets))
+
+	for i, s := range sheets {
+		name := xlsxSheetName(s.Name, i+1, used)
+		fmt.Fprintf(&b, `<sheet name="%s" sheetId="%d" r:id="rId%d"/>`, xmlEscape(name), i+1, i+1)
+	}
+
+	b.WriteString(`</sheets></workbook>`)
+
+	return b.String()
+}
+
+func xlsxWorkbookRels(n int) string {
+	var b strings.Builder
+
+	b.WriteString(xlsxHeader)
+	b.WriteString(`<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">`)
+
+	for i := 1; i <= n; i++ {
+		fmt.Fprintf(&b, `<Relationship Id="rId%d" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/worksheet" Target="worksheets/sheet%d.xml"/>`, i, i)
+	}
+
+	fmt.Fprintf(&b, `<Relationship Id="rId%d" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/styles" Target="styles.xml"/>`, n+1)
+	b.WriteString(`</Relationships>`)
+
+	return b.String()
+}
+
+func xlsxWorksheet(s xlsxSheet) string {
+	var b strings.Builder
+
+	b.WriteString(xlsxHeader)
+	b.WriteString(`<worksheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main"><sheetData>`)
+
+	writeRow := func(r int, cells []string, header bool) {
+		fmt.Fprintf(&b, `<row r="%d">`, r)
+
+		for c, v := range cells {
+			ref := xlsxColumn(c) + strconv.Itoa(r)
+
+			switch {
+			case header:
+				fmt.Fprintf(&b, `<c r="%s" s="1" t="inlineStr"><is><t xml:space="preserve">%s</t></is></c>`, ref, xmlEscape(v))
+			case xlsxNumber.MatchString(v):
+				fmt.Fprintf(&b, `<c r="%s"><v>%s</v></c>`, ref, v)
+			default:
+				fmt.Fprintf(&b, `<c r="%s" t="inlineStr"><is><t xml:space="preserve">%s</t></is></c>`, ref, xmlEscape(v))
+			}
+		}
+
+		b.WriteString(`</row>`)
+	}
+
+	writeRow(1, s.Headers, true)
+
+	for i, row := range s.Rows {
+		writeRow(i+2, row, false)
+	}
+
+	b.WriteString(`</sheetData></worksheet>`)
+
+	return b.String()
+}
+
+// xlsxNumber matches plain integers and decimals that are safe to store as
+// numeric cells (no leading zeros, so IDs like "007" stay text).
+var xlsxNumber = regexp.MustCompile(`^-?(0|[1-9][0-9]{0,14})(\.[0-9]+)?$`)
+
+// xlsxColumn converts a 0-based column index to a spreadsheet column (A, B, ..., AA).
+func xlsxColumn(i int) string {
+	name := ""
+	for i >= 0 {
+		name = string(rune('A'+i%26)) + name
+		i = i/26 - 1
+	}
+
+	return name
+}
+
+// xlsxSheetName makes name valid as a sheet name: at most 31 characters,
+// none of []:*?/\, and unique within the workbook.
+func xlsxSheetName(name string, n int, used map[string]bool) string {
+	name = strings.Map(func(r rune) rune {
+		if strings.ContainsRune(`[]:*?/\`, r) {
+			return '_'
+		}
+
+		return r
+	}, name)
+
+	if name == "" {
+		name = fmt.Sprintf("Sheet%d", n)
+	}
+
+	if r := []rune(name); len(r) > 31 {
+		name = string(r[:31])
+	}
+
+	for base, i := name, 2; used[strings.ToLower(name)]; i++ {
+		suffix := fmt.Sprintf(" (%d)", i)
+		name = string([]rune(base)[:min(len([]rune(base)), 31-len(suffix))]) + suffix
+	}
+
+	used[strings.ToLower(name)] = true
+
+	return name
+}
+
+func xmlEscape(s string) string {
+	var b strings.Builder
+
+	_ = xml.EscapeText(&b, []byte(s))
+
+	return b.String()
+}
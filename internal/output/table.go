@@ -12,7 +12,8 @@ import (
 
 // RenderTable writes a formatted table to w.
 // If colors are not enabled, falls back to SimpleTable.
-// If colors enabled, uses lipgloss/table with styled headers and alternating rows.
+// If colors enabled, uses lipgloss/table with headers and alternating rows
+// styled by the active theme.
 func RenderTable(w io.Writer, headers []string, rows [][]string, colors *Colors) error {
 	if colors == nil || !colors.Enabled() {
 		return SimpleTable(w, headers, rows)
@@ -23,13 +24,9 @@ func RenderTable(w io.Writer, headers []string, rows [][]string, colors *Colors)
 		return nil
 	}
 
-	headerStyle := lipgloss.NewStyle().
-		Bold(true).
-		Foreground(lipgloss.Color("#60a5fa")).
-		PaddingRight(2)
-
+	headerStyle := colors.headerStyle().PaddingRight(2)
 	defaultStyle := lipgloss.NewStyle().PaddingRight(2)
-	faintStyle := lipgloss.NewStyle().Faint(true).PaddingRight(2)
+	faintStyle := colors.mutedStyle().PaddingRight(2)
 
 	t := table.New().
 		Headers(headers...).
@@ -74,10 +71,40 @@ func SimpleTable(w io.Writer, headers []string, rows [][]string) error {
 	}
 
 	for _, row := range rows {
-		if _, err := fmt.Fprintln(tw, strings.Join(row, "\t")); err != nil {
-			return err
+		for _, line := range rowLines(row) {
+			if _, err := fmt.Fprintln(tw, strings.Join(line, "\t")); err != nil {
+				return err
+			}
 		}
 	}
 
 	return tw.Flush()
 }
+
+// rowLines splits a row with multi-line (wrapped) cells into one row per
+// line, leaving cells blank once their lines run out.
+func rowLines(row []string) [][]string {
+	height := 1
+	split := make([][]string, len(row))
+
+	for i, c := range row {
+		split[i] = strings.Split(c, "\n")
+		height = max(height, len(split[i]))
+	}
+
+	if height == 1 {
+		return [][]string{row}
+	}
+
+	lines := make([][]string, height)
+	for l := range lines {
+		lines[l] = make([]string, len(row))
+		for i := range row {
+			if l < len(split[i]) {
+				lines[l][i] = split[i][l]
+			}
+		}
+	}
+
+	return lines
+}
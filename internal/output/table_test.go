@@ -4,6 +4,11 @@ import (
 	"bytes"
 	"strings"
 	"testing"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/muesli/termenv"
+
+	"github.com/dedene/strawpoll-cli/internal/theme"
 )
 
 func TestRenderTable_ColorsDisabled(t *testing.T) {
@@ -132,3 +137,25 @@ func TestSimpleTable(t *testing.T) {
 		// Should produce no output (or minimal whitespace)
 	})
 }
+
+func TestRenderTable_Theme(t *testing.T) {
+	prev := lipgloss.ColorProfile()
+	lipgloss.SetColorProfile(termenv.TrueColor)
+	t.Cleanup(func() { lipgloss.SetColorProfile(prev) })
+
+	colors := &Colors{enabled: true, palette: theme.Palette{Header: "#ff0000", Muted: "#00ff00"}}
+
+	var buf bytes.Buffer
+	if err := RenderTable(&buf, []string{"Option"}, [][]string{{"a"}, {"b"}}, colors); err != nil {
+		t.Fatalf("RenderTable: %v", err)
+	}
+
+	out := buf.String()
+	if !strings.Contains(out, "38;2;255;0;0") {
+		t.Errorf("header should use the theme color: %q", out)
+	}
+
+	if !strings.Contains(out, "38;2;0;255;0") || strings.Contains(out, "\x1b[2m") {
+		t.Errorf("alternate rows should use the muted color, not faint: %q", out)
+	}
+}
@@ -0,0 +1,184 @@
+package output
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"reflect"
+	"strings"
+	"text/template"
+	"time"
+)
+
+// DefaultTimeLayout is used by the template "time" helper when no layout is given.
+const DefaultTimeLayout = "2006-01-02 15:04"
+
+// ParseTemplate parses a --template string with the built-in helper
+// functions plus any extra ones (extra entries override built-ins).
+//
+// Built-in helpers:
+//
+//	time  <unix|*unix|time.Time> [layout]  format a timestamp; layout may
+//	                                       also be "relative" or "iso"
+//	pct   <value> | <part> <total>         format a percentage, e.g. "40.0%"
+//	join  <list> <sep>                     join any slice into a string
+//	json  <value>                          compact JSON encoding
+func ParseTemplate(text string, extra template.FuncMap) (*template.Template, error) {
+	funcs := template.FuncMap{
+		"time": templateTime,
+		"pct":  templatePct,
+		"join": templateJoin,
+		"json": templateJSON,
+	}
+
+	for name, fn := range extra {
+		funcs[name] = fn
+	}
+
+	tmpl, err := template.New("output").Funcs(funcs).Option("missingkey=error").Parse(text)
+	if err != nil {
+		return nil, fmt.Errorf("parse --template: %w", err)
+	}
+
+	return tmpl, nil
+}
+
+// writeTemplate executes tmpl once per element when v is a slice, or once
+// for v otherwise, ending each result with a newline.
+func writeTemplate(w io.Writer, tmpl *template.Template, v any) error {
+	rv := reflect.ValueOf(v)
+	for rv.Kind() == reflect.Pointer && !rv.IsNil() {
+		rv = rv.Elem()
+	}
+
+	items := []any{v}
+
+	if rv.Kind() == reflect.Slice || rv.Kind() == reflect.Array {
+		items = make([]any, rv.Len())
+		for i := range items {
+			items[i] = rv.Index(i).Interface()
+		}
+	}
+
+	for _, item := range items {
+		if err := tmpl.Execute(w, item); err != nil {
+			return fmt.Errorf("execute --template: %w", err)
+		}
+
+		if _, err := io.WriteString(w, "\n"); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func templateTime(v any, layout ...string) (string, error) {
+	format := DefaultTimeLayout
+	if len(layout) > 0 {
+		format = layout[0]
+	}
+
+	switch t := v.(type) {
+	case time.Time:
+		return formatLayout(t, format), nil
+	case *time.Time:
+		if t == nil {
+			return "", nil
+		}
+
+		return formatLayout(*t, format), nil
+	case *int64:
+		if t == nil {
+			return "", nil
+		}
+
+		return formatLayout(time.Unix(*t, 0), format), nil
+	}
+
+	n, ok := toFloat(v)
+	if !ok {
+		return "", fmt.Errorf("time: unsupported value %T", v)
+	}
+
+	return formatLayout(time.Unix(int64(n), 0), format), nil
+}
+
+// formatLayout formats t with a Go layout, or the special layouts
+// "relative" and "iso".
+func formatLayout(t time.Time, layout string) string {
+	switch layout {
+	case "relative":
+		return Relative(t, now())
+	case "iso":
+		return t.Format(time.RFC3339)
+	default:
+		return t.Format(layout)
+	}
+}
+
+func templatePct(v any, total ...any) (string, error) {
+	n, ok := toFloat(v)
+	if !ok {
+		return "", fmt.Errorf("pct: unsupported value %T", v)
+	}
+
+	if len(total) > 0 {
+		d, ok := toFloat(total[0])
+		if !ok {
+			return "", fmt.Errorf("pct: unsupported total %T", total[0])
+		}
+
+		if d == 0 {
+			n = 0
+		} else {
+			n = n / d * 100
+		}
+	}
+
+	return fmt.Sprintf("%.1f%%", n), nil
+}
+
+func templateJoin(list any, sep string) (string, error) {
+	rv := reflect.ValueOf(list)
+	if rv.Kind() != reflect.Slice && rv.Kind() != reflect.Array {
+		return "", fmt.Errorf("join: %T is not a list", list)
+	}
+
+	parts := make([]string, rv.Len())
+	for i := range parts {
+		elem := rv.Index(i)
+		for elem.Kind() == reflect.Pointer && !elem.IsNil() {
+			elem = elem.Elem()
+		}
+
+		parts[i] = fmt.Sprint(elem.Interface())
+	}
+
+	return strings.Join(parts, sep), nil
+}
+
+func templateJSON(v any) (string, error) {
+	b, err := json.Marshal(v)
+
+	return string(b), err
+}
+
+// toFloat converts any integer or float value (or pointer to one) to float64.
+func toFloat(v any) (float64, bool) {
+	rv := reflect.ValueOf(v)
+	for rv.Kind() == reflect.Pointer && !rv.IsNil() {
+		rv = rv.Elem()
+	}
+
+	switch rv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return float64(rv.Int()), true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return float64(rv.Uint()), true
+	case reflect.Float32, reflect.Float64:
+		return rv.Float(), true
+	default:
+		return 0, false
+	}
+}
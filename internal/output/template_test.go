@@ -0,0 +1,89 @@
+package output
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"text/template"
+	"time"
+)
+
+type templatePoll struct {
+	ID        string
+	Title     string
+	CreatedAt int64
+	Tags      []string
+	Votes     int
+	Total     int
+}
+
+func TestFormatterTemplate(t *testing.T) {
+	tmpl, err := ParseTemplate(`{{.ID}} {{.Title}} {{pct .Votes .Total}} {{join .Tags "|"}} {{time .CreatedAt "2006-01-02"}} {{url .ID}}`,
+		template.FuncMap{"url": func(id string) string { return "https://example.com/" + id }})
+	if err != nil {
+		t.Fatalf("ParseTemplate: %v", err)
+	}
+
+	created := time.Date(2025, 6, 1, 12, 0, 0, 0, time.Local).Unix()
+	polls := []*templatePoll{
+		{ID: "a1", Title: "Lunch", CreatedAt: created, Tags: []string{"x", "y"}, Votes: 2, Total: 5},
+		{ID: "b2", Title: "Dinner", CreatedAt: created, Votes: 0, Total: 0},
+	}
+
+	var buf bytes.Buffer
+
+	f := &Formatter{Writer: &buf, Mode: ModeJSON, Template: tmpl}
+	if err := f.Output(polls, []string{"ignored"}, nil); err != nil {
+		t.Fatalf("Output: %v", err)
+	}
+
+	want := "a1 Lunch 40.0% x|y 2025-06-01 https://example.com/a1\n" +
+		"b2 Dinner 0.0%  2025-06-01 https://example.com/b2\n"
+	if buf.String() != want {
+		t.Errorf("got %q, want %q", buf.String(), want)
+	}
+
+	buf.Reset()
+
+	if err := f.OutputSingle(polls[0], nil); err != nil {
+		t.Fatalf("OutputSingle: %v", err)
+	}
+
+	if !strings.HasPrefix(buf.String(), "a1 Lunch") || strings.Count(buf.String(), "\n") != 1 {
+		t.Errorf("single = %q", buf.String())
+	}
+}
+
+func TestParseTemplate_Errors(t *testing.T) {
+	if _, err := ParseTemplate("{{.ID", nil); err == nil {
+		t.Error("expected parse error")
+	}
+
+	tmpl, err := ParseTemplate("{{.Missing}}", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	f := &Formatter{Writer: &bytes.Buffer{}, Template: tmpl}
+	if err := f.OutputSingle(&templatePoll{}, nil); err == nil {
+		t.Error("expected execution error for unknown field")
+	}
+}
+
+func TestTemplatePct(t *testing.T) {
+	tests := []struct {
+		args []any
+		want string
+	}{
+		{[]any{42.25}, "42.2%"},
+		{[]any{1, 4}, "25.0%"},
+		{[]any{3, 0}, "0.0%"},
+	}
+
+	for _, tt := range tests {
+		got, err := templatePct(tt.args[0], tt.args[1:]...)
+		if err != nil || got != tt.want {
+			t.Errorf("pct(%v) = %q, %v; want %q", tt.args, got, err, tt.want)
+		}
+	}
+}
@@ -0,0 +1,270 @@
+package output
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"time"
+)
+
+// TimeStyle selects how timestamps are printed in human-readable output.
+type TimeStyle string
+
+const (
+	// TimeAbsolute prints calendar dates and clock times (default).
+	TimeAbsolute TimeStyle = "absolute"
+	// TimeRelative prints times relative to now, e.g. "3 days ago".
+	TimeRelative TimeStyle = "relative"
+	// TimeISO prints RFC 3339 timestamps.
+	TimeISO TimeStyle = "iso"
+)
+
+// TimeStyles lists the accepted time styles.
+var TimeStyles = []string{string(TimeAbsolute), string(TimeRelative), string(TimeISO)}
+
+// ParseTimeStyle parses a --time-format value; empty means absolute.
+func ParseTimeStyle(s string) (TimeStyle, error) {
+	switch st := TimeStyle(strings.ToLower(strings.TrimSpace(s))); st {
+	case "":
+		return TimeAbsolute, nil
+	case TimeAbsolute, TimeRelative, TimeISO:
+		return st, nil
+	}
+
+	return TimeAbsolute, fmt.Errorf("unknown time format %q (want %s)", s, strings.Join(TimeStyles, ", "))
+}
+
+// ParseClock reports whether a clock setting selects the 12-hour clock:
+// "12h", "24h", or "auto"/empty to follow the locale.
+func ParseClock(s string) (bool, error) {
+	switch strings.ToLower(strings.TrimSpace(s)) {
+	case "12h", "12":
+		return true, nil
+	case "24h", "24":
+		return false, nil
+	case "", "auto":
+		return LocaleUses12Hour(), nil
+	}
+
+	return false, fmt.Errorf("unknown clock %q (want 12h, 24h or auto)", s)
+}
+
+// hour12Regions are the locale regions that conventionally use a 12-hour clock.
+var hour12Regions = map[string]bool{
+	"US": true, "CA": true, "AU": true, "NZ": true, "PH": true,
+	"IN": true, "PK": true, "BD": true, "EG": true, "SA": true,
+}
+
+// LocaleUses12Hour reports whether the time locale ($LC_ALL, $LC_TIME or
+// $LANG, e.g. "en_US.UTF-8") conventionally uses a 12-hour clock.
+func LocaleUses12Hour() bool {
+	locale := ""
+
+	for _, env := range []string{"LC_ALL", "LC_TIME", "LANG"} {
+		if v := os.Getenv(env); v != "" {
+			locale = v
+
+			break
+		}
+	}
+
+	locale, _, _ = strings.Cut(locale, ".")
+	locale, _, _ = strings.Cut(locale, "@")
+
+	_, region, ok := strings.Cut(locale, "_")
+	if !ok {
+		_, region, _ = strings.Cut(locale, "-")
+	}
+
+	return hour12Regions[strings.ToUpper(region)]
+}
+
+// TimeFormat controls how timestamps are printed.
+type TimeFormat struct {
+	Style  TimeStyle
+	Hour12 bool
+}
+
+var (
+	timeFormat = TimeFormat{Style: TimeAbsolute}
+	// now is replaced in tests.
+	now = time.Now
+)
+
+// SetTimeFormat sets the format used by Times.
+func SetTimeFormat(tf TimeFormat) {
+	timeFormat = tf
+}
+
+// Times returns the configured time format.
+func Times() TimeFormat {
+	return timeFormat
+}
+
+// Clock formats the time of day, e.g. "15:04" or "3:04 PM".
+func (tf TimeFormat) Clock(t time.Time) string {
+	if tf.Hour12 {
+		return t.Format("3:04 PM")
+	}
+
+	return t.Format("15:04")
+}
+
+// ClockSeconds is Clock with seconds, for refresh times.
+func (tf TimeFormat) ClockSeconds(t time.Time) string {
+	if tf.Hour12 {
+		return t.Format("3:04:05 PM")
+	}
+
+	return t.Format("15:04:05")
+}
+
+// Date formats a timestamp for list columns: "2006-01-02", "3 days ago"
+// or RFC 3339.
+func (tf TimeFormat) Date(t time.Time) string {
+	switch tf.Style {
+	case TimeRelative:
+		return Relative(t, now())
+	case TimeISO:
+		return t.Format(time.RFC3339)
+	default:
+		return t.Format("2006-01-02")
+	}
+}
+
+// DateTime formats a timestamp with its time of day: "2006-01-02 15:04",
+// "3 days ago" or RFC 3339.
+func (tf TimeFormat) DateTime(t time.Time) string {
+	switch tf.Style {
+	case TimeRelative:
+		return Relative(t, now())
+	case TimeISO:
+		return t.Format(time.RFC3339)
+	default:
+		return t.Format("2006-01-02") + " " + tf.Clock(t)
+	}
+}
+
+// Day formats a calendar day such as an all-day meeting option: "Mon Jan 2",
+// or "2006-01-02" in ISO style.
+func (tf TimeFormat) Day(t time.Time) string {
+	if tf.Style == TimeISO {
+		return t.Format("2006-01-02")
+	}
+
+	return t.Format("Mon Jan 2")
+}
+
+// Slot formats a time range on one day, e.g. "Mon Jan 2 10:00-11:00", or an
+// ISO 8601 interval in ISO style.
+func (tf TimeFormat) Slot(start, end time.Time) string {
+	if tf.Style == TimeISO {
+		return start.Format(time.RFC3339) + "/" + end.Format(time.RFC3339)
+	}
+
+	return tf.Day(start) + " " + tf.Clock(start) + "-" + tf.Clock(end)
+}
+
+// Deadline describes a poll deadline with the time left: "2006-01-02 15:04
+// (closes in 2h 5m)", "closes in 2h 5m" or RFC 3339.
+func (tf TimeFormat) Deadline(t time.Time) string {
+	switch tf.Style {
+	case TimeRelative:
+		return Closes(t, now())
+	case TimeISO:
+		return t.Format(time.RFC3339)
+	default:
+		return tf.DateTime(t) + " (" + Closes(t, now()) + ")"
+	}
+}
+
+// Closes is a short deadline column value: the time left, or when the poll
+// closed; RFC 3339 in ISO style.
+func (tf TimeFormat) Closes(t time.Time) string {
+	if tf.Style == TimeISO {
+		return t.Format(time.RFC3339)
+	}
+
+	return Closes(t, now())
+}
+
+// Closes describes a deadline relative to now: "closes in 2h 5m" or
+// "closed 3 days ago".
+func Closes(deadline, now time.Time) string {
+	if left := deadline.Sub(now); left > 0 {
+		return "closes in " + Remaining(left)
+	}
+
+	return "closed " + Relative(deadline, now)
+}
+
+// Remaining renders a duration left, e.g. "2h 5m"; "closed" when none is left.
+func Remaining(d time.Duration) string {
+	if d <= 0 {
+		return "closed"
+	}
+
+	d = d.Round(time.Second)
+	days := int(d.Hours()) / 24
+	hours := int(d.Hours()) % 24
+	mins := int(d.Minutes()) % 60
+	secs := int(d.Seconds()) % 60
+
+	switch {
+	case days > 0:
+		return fmt.Sprintf("%dd %dh", days, hours)
+	case hours > 0:
+		return fmt.Sprintf("%dh %dm", hours, mins)
+	case mins > 0:
+		return fmt.Sprintf("%dm %ds", mins, secs)
+	default:
+		return fmt.Sprintf("%ds", secs)
+	}
+}
+
+// Relative describes t relative to now in the largest whole unit, e.g.
+// "3 days ago" or "in 2 hours".
+func Relative(t, now time.Time) string {
+	d := now.Sub(t)
+
+	future := d < 0
+	if future {
+		d = -d
+	}
+
+	if d < time.Minute {
+		return "just now"
+	}
+
+	units := []struct {
+		name string
+		size time.Duration
+	}{
+		{"year", 365 * 24 * time.Hour},
+		{"month", 30 * 24 * time.Hour},
+		{"week", 7 * 24 * time.Hour},
+		{"day", 24 * time.Hour},
+		{"hour", time.Hour},
+		{"minute", time.Minute},
+	}
+
+	for _, u := range units {
+		n := int(d / u.size)
+		if n < 1 {
+			continue
+		}
+
+		phrase := fmt.Sprintf("%d %s", n, u.name)
+		if n > 1 {
+			phrase += "s"
+		}
+
+		if future {
+			return "in " + phrase
+		}
+
+		return phrase + " ago"
+	}
+
+	return "just now"
+}
@@ -0,0 +1,90 @@
+package output
+
+import (
+	"testing"
+	"time"
+)
+
+func TestRelative(t *testing.T) {
+	base := time.Date(2025, 6, 10, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		t    time.Time
+		want string
+	}{
+		{base.Add(-30 * time.Second), "just now"},
+		{base.Add(-time.Minute), "1 minute ago"},
+		{base.Add(-3 * 24 * time.Hour), "3 days ago"},
+		{base.Add(-15 * 24 * time.Hour), "2 weeks ago"},
+		{base.Add(2*time.Hour + 10*time.Minute), "in 2 hours"},
+		{base.Add(-400 * 24 * time.Hour), "1 year ago"},
+	}
+
+	for _, tt := range tests {
+		if got := Relative(tt.t, base); got != tt.want {
+			t.Errorf("Relative(%v) = %q, want %q", tt.t.Sub(base), got, tt.want)
+		}
+	}
+}
+
+func TestTimeFormat(t *testing.T) {
+	fixed := time.Date(2025, 6, 10, 12, 0, 0, 0, time.UTC)
+	now = func() time.Time { return fixed }
+	t.Cleanup(func() { now = time.Now })
+
+	start := time.Date(2025, 6, 12, 14, 30, 0, 0, time.UTC)
+	end := start.Add(time.Hour)
+
+	tests := []struct {
+		name string
+		got  string
+		want string
+	}{
+		{"absolute date", TimeFormat{}.Date(start), "2025-06-12"},
+		{"relative date", TimeFormat{Style: TimeRelative}.Date(start), "in 2 days"},
+		{"iso date", TimeFormat{Style: TimeISO}.Date(start), "2025-06-12T14:30:00Z"},
+		{"24h slot", TimeFormat{}.Slot(start, end), "Thu Jun 12 14:30-15:30"},
+		{"12h slot", TimeFormat{Hour12: true}.Slot(start, end), "Thu Jun 12 2:30 PM-3:30 PM"},
+		{"iso slot", TimeFormat{Style: TimeISO}.Slot(start, end), "2025-06-12T14:30:00Z/2025-06-12T15:30:00Z"},
+		{"deadline", TimeFormat{}.Deadline(start), "2025-06-12 14:30 (closes in 2d 2h)"},
+		{"relative deadline", TimeFormat{Style: TimeRelative}.Deadline(start), "closes in 2d 2h"},
+		{"closed", TimeFormat{}.Closes(fixed.Add(-3 * 24 * time.Hour)), "closed 3 days ago"},
+	}
+
+	for _, tt := range tests {
+		if tt.got != tt.want {
+			t.Errorf("%s = %q, want %q", tt.name, tt.got, tt.want)
+		}
+	}
+}
+
+func TestLocaleUses12Hour(t *testing.T) {
+	tests := []struct {
+		locale string
+		want   bool
+	}{
+		{"en_US.UTF-8", true},
+		{"en_GB.UTF-8", false},
+		{"de_DE", false},
+		{"en-AU", true},
+		{"C", false},
+	}
+
+	for _, tt := range tests {
+		t.Setenv("LC_ALL", tt.locale)
+
+		if got := LocaleUses12Hour(); got != tt.want {
+			t.Errorf("LocaleUses12Hour(%q) = %v, want %v", tt.locale, got, tt.want)
+		}
+	}
+}
+
+func TestParseTimeStyle(t *testing.T) {
+	if st, err := ParseTimeStyle("Relative"); err != nil || st != TimeRelative {
+		t.Errorf("ParseTimeStyle(Relative) = %q, %v", st, err)
+	}
+
+	if _, err := ParseTimeStyle("epoch"); err == nil {
+		t.Error("expected error for unknown style")
+	}
+}
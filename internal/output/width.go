@@ -0,0 +1,78 @@
+package output
+
+import (
+	"io"
+	"os"
+	"strconv"
+
+	"github.com/charmbracelet/lipgloss"
+	"golang.org/x/term"
+)
+
+// DefaultWidth is used when the terminal width cannot be determined.
+const DefaultWidth = 80
+
+// TerminalWidth returns the column count for w: $COLUMNS if set,
+// else the terminal size when w is a terminal, else DefaultWidth.
+func TerminalWidth(w io.Writer) int {
+	if width := TableWidth(w); width > 0 {
+		return width
+	}
+
+	return DefaultWidth
+}
+
+// TableWidth is like TerminalWidth but returns 0 when the width is unknown,
+// so output redirected to a file or pipe is never truncated.
+func TableWidth(w io.Writer) int {
+	if cols := os.Getenv("COLUMNS"); cols != "" {
+		if n, err := strconv.Atoi(cols); err == nil && n > 0 {
+			return n
+		}
+	}
+
+	if f, ok := w.(*os.File); ok {
+		if width, _, err := term.GetSize(int(f.Fd())); err == nil && width > 0 {
+			return width
+		}
+	}
+
+	return 0
+}
+
+// Truncate shortens s to at most width display columns, ending in an ellipsis
+// when anything was cut.
+func Truncate(s string, width int) string {
+	if width <= 0 {
+		return ""
+	}
+
+	if lipgloss.Width(s) <= width {
+		return s
+	}
+
+	r := []rune(s)
+	for len(r) > 0 && lipgloss.Width(string(r))+1 > width {
+		r = r[:len(r)-1]
+	}
+
+	return string(r) + "…"
+}
+
+// TerminalHeight returns the row count for w: $LINES if set, else the
+// terminal size when w is a terminal, else 0.
+func TerminalHeight(w io.Writer) int {
+	if lines := os.Getenv("LINES"); lines != "" {
+		if n, err := strconv.Atoi(lines); err == nil && n > 0 {
+			return n
+		}
+	}
+
+	if f, ok := w.(*os.File); ok {
+		if _, height, err := term.GetSize(int(f.Fd())); err == nil && height > 0 {
+			return height
+		}
+	}
+
+	return 0
+}
@@ -0,0 +1,223 @@
+package ranking
+
+import "sort"
+
+// countSchulze ranks options by the number of others they beat through
+// strongest paths of pairwise wins, and reports the winner's paths.
+func countSchulze(ballots []Ballot, n int) Result {
+	d := Pairwise(ballots, n)
+	p := StrongestPaths(d)
+	scores := make([]float64, n)
+
+	for i := range n {
+		for j := range n {
+			if i != j && p[i][j] > p[j][i] {
+				scores[i]++
+			}
+		}
+	}
+
+	res := Result{Method: Schulze, Scores: scores, Order: orderByScore(scores)}
+	if n == 0 {
+		return res
+	}
+
+	winner := res.Order[0]
+
+	for _, o := range res.Order[1:] {
+		if p[winner][o] > 0 {
+			res.Paths = append(res.Paths, Path{
+				From:     winner,
+				To:       o,
+				Via:      widestPath(d, winner, o, p[winner][o]),
+				Strength: p[winner][o],
+				Reverse:  p[o][winner],
+			})
+		}
+	}
+
+	return res
+}
+
+// StrongestPaths computes the Schulze path strengths from the pairwise
+// matrix d: p[i][j] is the strength of the strongest path from i to j,
+// where a path's strength is its weakest pairwise win.
+func StrongestPaths(d [][]int) [][]int {
+	n := len(d)
+	p := make([][]int, n)
+
+	for i := range p {
+		p[i] = make([]int, n)
+
+		for j := range n {
+			if i != j && d[i][j] > d[j][i] {
+				p[i][j] = d[i][j]
+			}
+		}
+	}
+
+	for k := range n {
+		for i := range n {
+			if i == k {
+				continue
+			}
+
+			for j := range n {
+				if j != i && j != k {
+					p[i][j] = max(p[i][j], min(p[i][k], p[k][j]))
+				}
+			}
+		}
+	}
+
+	return p
+}
+
+// widestPath finds the shortest chain of pairwise wins of at least strength
+// each from i to j.
+func widestPath(d [][]int, from, to, strength int) []int {
+	prev := make([]int, len(d))
+	for i := range prev {
+		prev[i] = -1
+	}
+
+	prev[from] = from
+	queue := []int{from}
+
+	for len(queue) > 0 && prev[to] < 0 {
+		a := queue[0]
+		queue = queue[1:]
+
+		for b := range d {
+			if prev[b] < 0 && d[a][b] > d[b][a] && d[a][b] >= strength {
+				prev[b] = a
+				queue = append(queue, b)
+			}
+		}
+	}
+
+	if prev[to] < 0 {
+		return nil
+	}
+
+	path := []int{to}
+	for at := to; at != from; at = prev[at] {
+		path = append([]int{prev[at]}, path...)
+	}
+
+	return path
+}
+
+// countRankedPairs locks pairwise majorities from strongest to weakest,
+// skipping any that would create a cycle, and orders options by the
+// resulting graph.
+func countRankedPairs(ballots []Ballot, n int) Result {
+	d := Pairwise(ballots, n)
+
+	var pairs []Pair
+
+	for i := range n {
+		for j := i + 1; j < n; j++ {
+			switch {
+			case d[i][j] > d[j][i]:
+				pairs = append(pairs, Pair{Winner: i, Loser: j, For: d[i][j], Against: d[j][i]})
+			case d[j][i] > d[i][j]:
+				pairs = append(pairs, Pair{Winner: j, Loser: i, For: d[j][i], Against: d[i][j]})
+			}
+		}
+	}
+
+	// Strongest first: most voters for the winner, then fewest against.
+	sort.SliceStable(pairs, func(a, b int) bool {
+		if pairs[a].For != pairs[b].For {
+			return pairs[a].For > pairs[b].For
+		}
+
+		return pairs[a].Against < pairs[b].Against
+	})
+
+	locked := make([][]bool, n)
+	for i := range locked {
+		locked[i] = make([]bool, n)
+	}
+
+	scores := make([]float64, n)
+
+	for k, pr := range pairs {
+		if reaches(locked, pr.Loser, pr.Winner) {
+			continue
+		}
+
+		locked[pr.Winner][pr.Loser] = true
+		pairs[k].Locked = true
+		scores[pr.Winner]++
+	}
+
+	return Result{Method: RankedPairs, Scores: scores, Order: lockedOrder(locked, scores), Pairs: pairs}
+}
+
+// reaches reports whether to is reachable from from over locked edges.
+func reaches(locked [][]bool, from, to int) bool {
+	seen := make([]bool, len(locked))
+	stack := []int{from}
+
+	for len(stack) > 0 {
+		a := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+
+		if a == to {
+			return true
+		}
+
+		if seen[a] {
+			continue
+		}
+
+		seen[a] = true
+
+		for b, ok := range locked[a] {
+			if ok && !seen[b] {
+				stack = append(stack, b)
+			}
+		}
+	}
+
+	return false
+}
+
+// lockedOrder repeatedly takes the option no remaining option is locked
+// over, preferring more locked wins and then poll order.
+func lockedOrder(locked [][]bool, scores []float64) []int {
+	n := len(locked)
+	placed := make([]bool, n)
+	order := make([]int, 0, n)
+
+	for len(order) < n {
+		best := -1
+
+		for i := range n {
+			if placed[i] {
+				continue
+			}
+
+			beaten := false
+
+			for j := range n {
+				if !placed[j] && locked[j][i] {
+					beaten = true
+
+					break
+				}
+			}
+
+			if !beaten && (best < 0 || scores[i] > scores[best]) {
+				best = i
+			}
+		}
+
+		placed[best] = true
+		order = append(order, best)
+	}
+
+	return order
+}
@@ -0,0 +1,338 @@
+package ranking
+
+import (
+	"bufio"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"path/filepath"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ExportFormat is a standard ballot file format.
+type ExportFormat string
+
+const (
+	// ExportBLT is the BLT format read by OpenSTV-style counting tools.
+	ExportBLT ExportFormat = "blt"
+	// ExportPrefLibSOI is PrefLib's strict orders, incomplete list.
+	ExportPrefLibSOI ExportFormat = "preflib-soi"
+	// ExportPrefLibTOI is PrefLib's orders with ties, incomplete list.
+	ExportPrefLibTOI ExportFormat = "preflib-toi"
+	// ExportCSVBallots is one ballot per CSV row, one rank column per option.
+	ExportCSVBallots ExportFormat = "csv-ballots"
+)
+
+// ExportFormats lists the supported ballot formats.
+var ExportFormats = []ExportFormat{ExportBLT, ExportPrefLibSOI, ExportPrefLibTOI, ExportCSVBallots}
+
+// ParseExportFormat resolves the ballot format from an explicit name, or
+// from the extension of path (.blt, .soi, .toi, .csv) when name is empty.
+func ParseExportFormat(s, path string) (ExportFormat, error) {
+	if s == "" {
+		s = strings.TrimPrefix(filepath.Ext(path), ".")
+		if s == "" {
+			return "", fmt.Errorf("cannot infer ballot format: use --format or an output file with an extension")
+		}
+	}
+
+	f := ExportFormat(strings.ToLower(strings.TrimSpace(s)))
+	switch f {
+	case "soi", "preflib":
+		return ExportPrefLibSOI, nil
+	case "toi":
+		return ExportPrefLibTOI, nil
+	case "csv":
+		return ExportCSVBallots, nil
+	}
+
+	if slices.Contains(ExportFormats, f) {
+		return f, nil
+	}
+
+	names := make([]string, len(ExportFormats))
+	for i, f := range ExportFormats {
+		names[i] = string(f)
+	}
+
+	return "", fmt.Errorf("unsupported ballot format %q (want %s)", s, strings.Join(names, ", "))
+}
+
+// Preference is a ballot that may rank options equally: groups of option
+// indexes from most to least preferred. Options in no group are unranked.
+type Preference [][]int
+
+// PreferenceFromPositions builds a preference from per-option positions,
+// where positions[i] is the 0-based rank given to option i or nil if
+// unranked. Gaps in the positions are closed and equal positions form one
+// group.
+func PreferenceFromPositions(positions []*int) Preference {
+	var ranked []int
+
+	for i, p := range positions {
+		if p != nil {
+			ranked = append(ranked, i)
+		}
+	}
+
+	sort.SliceStable(ranked, func(a, b int) bool { return *positions[ranked[a]] < *positions[ranked[b]] })
+
+	var pref Preference
+
+	for k, i := range ranked {
+		if k > 0 && *positions[i] == *positions[ranked[k-1]] {
+			pref[len(pref)-1] = append(pref[len(pref)-1], i)
+
+			continue
+		}
+
+		pref = append(pref, []int{i})
+	}
+
+	return pref
+}
+
+// HasTies reports whether the preference ranks two options equally.
+func (p Preference) HasTies() bool {
+	for _, g := range p {
+		if len(g) > 1 {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (p Preference) key() string {
+	var b strings.Builder
+
+	for _, g := range p {
+		for k, i := range g {
+			if k > 0 {
+				b.WriteByte('=')
+			}
+
+			b.WriteString(strconv.Itoa(i))
+		}
+
+		b.WriteByte('>')
+	}
+
+	return b.String()
+}
+
+// Election is a set of ballots to export.
+type Election struct {
+	Title   string
+	Options []string
+	// Seats is the number of winners, written to BLT files.
+	Seats int
+	// Voters names each ballot's voter, for CSV ballots.
+	Voters  []string
+	Ballots []Preference
+	// Date is the export date, written to PrefLib headers.
+	Date time.Time
+}
+
+// WriteBallots writes the election's ballots in format.
+func WriteBallots(w io.Writer, e *Election, format ExportFormat) error {
+	switch format {
+	case ExportBLT:
+		return writeBLT(w, e)
+	case ExportPrefLibSOI, ExportPrefLibTOI:
+		return writePrefLib(w, e, format)
+	case ExportCSVBallots:
+		return writeCSVBallots(w, e)
+	default:
+		return fmt.Errorf("unsupported ballot format %q", format)
+	}
+}
+
+// weighted groups identical ballots, most common first, ties in the
+// order they first appear.
+type weighted struct {
+	pref  Preference
+	count int
+}
+
+func groupBallots(ballots []Preference, skipBlank bool) []weighted {
+	var (
+		out   []weighted
+		index = make(map[string]int)
+	)
+
+	for _, b := range ballots {
+		if skipBlank && len(b) == 0 {
+			continue
+		}
+
+		k := b.key()
+		if i, ok := index[k]; ok {
+			out[i].count++
+
+			continue
+		}
+
+		index[k] = len(out)
+		out = append(out, weighted{pref: b, count: 1})
+	}
+
+	sort.SliceStable(out, func(a, b int) bool { return out[a].count > out[b].count })
+
+	return out
+}
+
+// writeBLT writes the BLT format: a "candidates seats" line, one
+// "weight prefs... 0" line per distinct ballot with 1-based candidate
+// numbers, a terminating 0, then quoted candidate names and the title.
+// Blank ballots are kept as "weight 0"; equal ranks are joined with "=".
+func writeBLT(w io.Writer, e *Election) error {
+	bw := bufio.NewWriter(w)
+
+	fmt.Fprintf(bw, "%d %d\n", len(e.Options), max(e.Seats, 1))
+
+	for _, b := range groupBallots(e.Ballots, false) {
+		fmt.Fprintf(bw, "%d", b.count)
+
+		for _, g := range b.pref {
+			fmt.Fprintf(bw, " %s", joinGroup(g, "="))
+		}
+
+		fmt.Fprintln(bw, " 0")
+	}
+
+	fmt.Fprintln(bw, "0")
+
+	for _, name := range e.Options {
+		fmt.Fprintln(bw, bltQuote(name))
+	}
+
+	fmt.Fprintln(bw, bltQuote(e.Title))
+
+	return bw.Flush()
+}
+
+func bltQuote(s string) string {
+	return `"` + strings.ReplaceAll(s, `"`, `'`) + `"`
+}
+
+// writePrefLib writes a PrefLib SOI or TOI file. Blank ballots rank
+// nothing and have no PrefLib form, so they are left out of the counts.
+func writePrefLib(w io.Writer, e *Election, format ExportFormat) error {
+	groups := groupBallots(e.Ballots, true)
+	voters := 0
+
+	for _, b := range groups {
+		if format == ExportPrefLibSOI && b.pref.HasTies() {
+			return fmt.Errorf("ballots rank options equally; use %s", ExportPrefLibTOI)
+		}
+
+		voters += b.count
+	}
+
+	dataType := "soi"
+	if format == ExportPrefLibTOI {
+		dataType = "toi"
+	}
+
+	date := e.Date.Format(time.DateOnly)
+	bw := bufio.NewWriter(w)
+
+	fmt.Fprintf(bw, "# FILE NAME: %s.%s\n", prefLibName(e.Title), dataType)
+	fmt.Fprintf(bw, "# TITLE: %s\n", oneLine(e.Title))
+	fmt.Fprintln(bw, "# DESCRIPTION: ")
+	fmt.Fprintf(bw, "# DATA TYPE: %s\n", dataType)
+	fmt.Fprintln(bw, "# MODIFICATION TYPE: original")
+	fmt.Fprintln(bw, "# RELATES TO: ")
+	fmt.Fprintln(bw, "# RELATED FILES: ")
+	fmt.Fprintf(bw, "# PUBLICATION DATE: %s\n", date)
+	fmt.Fprintf(bw, "# MODIFICATION DATE: %s\n", date)
+	fmt.Fprintf(bw, "# NUMBER ALTERNATIVES: %d\n", len(e.Options))
+	fmt.Fprintf(bw, "# NUMBER VOTERS: %d\n", voters)
+	fmt.Fprintf(bw, "# NUMBER UNIQUE ORDERS: %d\n", len(groups))
+
+	for i, name := range e.Options {
+		fmt.Fprintf(bw, "# ALTERNATIVE NAME %d: %s\n", i+1, oneLine(name))
+	}
+
+	for _, b := range groups {
+		parts := make([]string, len(b.pref))
+		for k, g := range b.pref {
+			parts[k] = joinGroup(g, ",")
+			if len(g) > 1 {
+				parts[k] = "{" + parts[k] + "}"
+			}
+		}
+
+		fmt.Fprintf(bw, "%d: %s\n", b.count, strings.Join(parts, ","))
+	}
+
+	return bw.Flush()
+}
+
+func prefLibName(title string) string {
+	name := strings.Map(func(r rune) rune {
+		switch {
+		case r >= 'a' && r <= 'z', r >= '0' && r <= '9':
+			return r
+		case r >= 'A' && r <= 'Z':
+			return r + ('a' - 'A')
+		default:
+			return '-'
+		}
+	}, title)
+
+	name = strings.Trim(name, "-")
+	if name == "" {
+		return "ballots"
+	}
+
+	return name
+}
+
+func oneLine(s string) string {
+	return strings.Join(strings.Fields(s), " ")
+}
+
+// joinGroup renders a group as 1-based option numbers joined by sep.
+func joinGroup(g []int, sep string) string {
+	nums := make([]string, len(g))
+	for k, i := range g {
+		nums[k] = strconv.Itoa(i + 1)
+	}
+
+	return strings.Join(nums, sep)
+}
+
+// writeCSVBallots writes one row per ballot: the voter, then the rank each
+// option received (1 is best), blank when unranked.
+func writeCSVBallots(w io.Writer, e *Election) error {
+	cw := csv.NewWriter(w)
+
+	_ = cw.Write(append([]string{"Voter"}, e.Options...))
+
+	for b, pref := range e.Ballots {
+		row := make([]string, 1+len(e.Options))
+		if b < len(e.Voters) {
+			row[0] = e.Voters[b]
+		}
+
+		for rank, g := range pref {
+			for _, i := range g {
+				if i < len(e.Options) {
+					row[1+i] = strconv.Itoa(rank + 1)
+				}
+			}
+		}
+
+		_ = cw.Write(row)
+	}
+
+	cw.Flush()
+
+	return cw.Error()
+}
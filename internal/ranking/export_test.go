@@ -0,0 +1,119 @@
+package ranking
+
+import (
+	"bytes"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+)
+
+func testElection() *Election {
+	return &Election{
+		Title:   "Team lunch",
+		Options: []string{"Pizza", "Sushi", "Tacos"},
+		Seats:   1,
+		Voters:  []string{"Ann", "Bob", "Cy", "Dee", "Eve"},
+		Ballots: []Preference{
+			{{0}, {1}, {2}},
+			{{1}, {0}},
+			{{0}, {1}, {2}},
+			{},
+			{{2}, {0, 1}},
+		},
+		Date: time.Date(2026, 10, 18, 0, 0, 0, 0, time.UTC),
+	}
+}
+
+func TestPreferenceFromPositions(t *testing.T) {
+	pos := func(v int) *int { return &v }
+
+	got := PreferenceFromPositions([]*int{pos(3), nil, pos(0), pos(3)})
+	want := Preference{{2}, {0, 3}}
+
+	if !slices.EqualFunc(got, want, slices.Equal) {
+		t.Errorf("PreferenceFromPositions = %v, want %v", got, want)
+	}
+}
+
+func TestWriteBallots_BLT(t *testing.T) {
+	var buf bytes.Buffer
+	if err := WriteBallots(&buf, testElection(), ExportBLT); err != nil {
+		t.Fatal(err)
+	}
+
+	want := `3 1
+2 1 2 3 0
+1 2 1 0
+1 0
+1 3 1=2 0
+0
+"Pizza"
+"Sushi"
+"Tacos"
+"Team lunch"
+`
+	if buf.String() != want {
+		t.Errorf("BLT =\n%s\nwant\n%s", buf.String(), want)
+	}
+}
+
+func TestWriteBallots_PrefLib(t *testing.T) {
+	if err := WriteBallots(&bytes.Buffer{}, testElection(), ExportPrefLibSOI); err == nil {
+		t.Error("SOI with tied ranks: want error")
+	}
+
+	var buf bytes.Buffer
+	if err := WriteBallots(&buf, testElection(), ExportPrefLibTOI); err != nil {
+		t.Fatal(err)
+	}
+
+	out := buf.String()
+	for _, want := range []string{
+		"# FILE NAME: team-lunch.toi\n",
+		"# DATA TYPE: toi\n",
+		"# PUBLICATION DATE: 2026-10-18\n",
+		"# NUMBER VOTERS: 4\n",
+		"# NUMBER UNIQUE ORDERS: 3\n",
+		"# ALTERNATIVE NAME 2: Sushi\n",
+		"\n2: 1,2,3\n1: 2,1\n1: 3,{1,2}\n",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("TOI missing %q in\n%s", want, out)
+		}
+	}
+}
+
+func TestWriteBallots_CSV(t *testing.T) {
+	var buf bytes.Buffer
+	if err := WriteBallots(&buf, testElection(), ExportCSVBallots); err != nil {
+		t.Fatal(err)
+	}
+
+	want := "Voter,Pizza,Sushi,Tacos\nAnn,1,2,3\nBob,2,1,\nCy,1,2,3\nDee,,,\nEve,2,2,1\n"
+	if buf.String() != want {
+		t.Errorf("CSV =\n%s\nwant\n%s", buf.String(), want)
+	}
+}
+
+func TestParseExportFormat(t *testing.T) {
+	tests := []struct {
+		name, path string
+		want       ExportFormat
+		wantErr    bool
+	}{
+		{name: "blt", want: ExportBLT},
+		{name: "PrefLib-SOI", want: ExportPrefLibSOI},
+		{path: "ballots.toi", want: ExportPrefLibTOI},
+		{path: "ballots.csv", want: ExportCSVBallots},
+		{path: "ballots", wantErr: true},
+		{name: "xlsx", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		got, err := ParseExportFormat(tt.name, tt.path)
+		if (err != nil) != tt.wantErr || got != tt.want {
+			t.Errorf("ParseExportFormat(%q, %q) = %q, %v", tt.name, tt.path, got, err)
+		}
+	}
+}
@@ -0,0 +1,133 @@
+package ranking
+
+import "slices"
+
+// countIRV runs instant-runoff rounds: each ballot counts for its highest
+// continuing option, and the option with the fewest votes is eliminated
+// until one holds a majority of the continuing ballots.
+func countIRV(ballots []Ballot, n int) Result {
+	active := make([]bool, n)
+	for i := range active {
+		active[i] = true
+	}
+
+	scores := make([]float64, n)
+	remaining := n
+
+	var (
+		rounds     []Round
+		eliminated []int
+	)
+
+	for remaining > 0 {
+		round := Round{Votes: make([]int, n)}
+
+		for _, b := range ballots {
+			top := -1
+
+			for _, o := range b {
+				if o < n && active[o] {
+					top = o
+
+					break
+				}
+			}
+
+			if top < 0 {
+				round.Exhausted++
+			} else {
+				round.Votes[top]++
+			}
+		}
+
+		continuing, leader, low := 0, -1, -1
+
+		for i := range n {
+			if !active[i] {
+				continue
+			}
+
+			continuing += round.Votes[i]
+
+			if leader < 0 || round.Votes[i] > round.Votes[leader] {
+				leader = i
+			}
+
+			if low < 0 || round.Votes[i] < round.Votes[low] {
+				low = i
+			}
+		}
+
+		var tied []int
+
+		for i := range n {
+			if active[i] && round.Votes[i] == round.Votes[low] {
+				tied = append(tied, i)
+			}
+		}
+
+		if remaining == 1 || round.Votes[leader]*2 > continuing || len(tied) == remaining {
+			rounds = append(rounds, round)
+
+			for i := range n {
+				if active[i] {
+					scores[i] = float64(round.Votes[i])
+				}
+			}
+
+			break
+		}
+
+		loser := irvTieBreak(tied, rounds)
+		round.Eliminated = []int{loser}
+		rounds = append(rounds, round)
+
+		active[loser] = false
+		scores[loser] = float64(round.Votes[loser])
+		eliminated = append(eliminated, loser)
+		remaining--
+	}
+
+	var order []int
+
+	for _, i := range orderByScore(scores) {
+		if active[i] {
+			order = append(order, i)
+		}
+	}
+
+	for _, i := range slices.Backward(eliminated) {
+		order = append(order, i)
+	}
+
+	return Result{Method: IRV, Scores: scores, Order: order, Rounds: rounds}
+}
+
+// irvTieBreak picks which of the options tied for fewest votes to eliminate:
+// the one with fewer votes in the most recent earlier round that separates
+// them, else the last in poll order.
+func irvTieBreak(tied []int, rounds []Round) int {
+	for _, r := range slices.Backward(rounds) {
+		low := tied[0]
+		for _, o := range tied {
+			if r.Votes[o] < r.Votes[low] {
+				low = o
+			}
+		}
+
+		var still []int
+
+		for _, o := range tied {
+			if r.Votes[o] == r.Votes[low] {
+				still = append(still, o)
+			}
+		}
+
+		tied = still
+		if len(tied) == 1 {
+			return tied[0]
+		}
+	}
+
+	return tied[len(tied)-1]
+}
@@ -0,0 +1,211 @@
+package ranking
+
+import "slices"
+
+// Pairwise returns the pairwise preference matrix: d[i][j] is the number of
+// ballots ranking option i above option j.
+func Pairwise(ballots []Ballot, n int) [][]int {
+	d := make([][]int, n)
+	for i := range d {
+		d[i] = make([]int, n)
+	}
+
+	for _, b := range ballots {
+		ranked := make([]bool, n)
+
+		for pos, i := range b {
+			if i >= n {
+				continue
+			}
+
+			ranked[i] = true
+
+			for _, j := range b[pos+1:] {
+				if j < n {
+					d[i][j]++
+				}
+			}
+		}
+
+		// Every ranked option beats every unranked one.
+		for _, i := range b {
+			if i >= n {
+				continue
+			}
+
+			for j := range n {
+				if !ranked[j] {
+					d[i][j]++
+				}
+			}
+		}
+	}
+
+	return d
+}
+
+// CondorcetWinner returns the option that beats every other option head to
+// head, if there is one.
+func CondorcetWinner(d [][]int) (int, bool) {
+	for i := range d {
+		if beatsAll(d, i, func(a, b int) bool { return a > b }) {
+			return i, true
+		}
+	}
+
+	return -1, false
+}
+
+// CondorcetLoser returns the option that loses to every other option head
+// to head, if there is one.
+func CondorcetLoser(d [][]int) (int, bool) {
+	for i := range d {
+		if beatsAll(d, i, func(a, b int) bool { return a < b }) {
+			return i, true
+		}
+	}
+
+	return -1, false
+}
+
+func beatsAll(d [][]int, i int, wins func(a, b int) bool) bool {
+	if len(d) < 2 {
+		return false
+	}
+
+	for j := range d {
+		if j != i && !wins(d[i][j], d[j][i]) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Cycles finds the majority cycles in d: groups of options where each one
+// is beaten, directly or through others in the group, by every other. Each
+// cycle is returned as a shortest chain of head-to-head wins starting and
+// ending at the group's first option, e.g. [A B C A].
+func Cycles(d [][]int) [][]int {
+	var cycles [][]int
+
+	for _, group := range majorityComponents(d) {
+		if len(group) < 2 {
+			continue
+		}
+
+		cycles = append(cycles, shortestCycle(d, group))
+	}
+
+	return cycles
+}
+
+// majorityComponents returns the strongly connected components of the
+// majority graph, where i→j when more voters prefer i over j. Members of a
+// component are sorted, and components are ordered by their first member.
+func majorityComponents(d [][]int) [][]int {
+	n := len(d)
+	index := make([]int, n)
+	low := make([]int, n)
+	onStack := make([]bool, n)
+
+	for i := range index {
+		index[i] = -1
+	}
+
+	var (
+		stack      []int
+		components [][]int
+		next       int
+		visit      func(v int)
+	)
+
+	visit = func(v int) {
+		index[v], low[v] = next, next
+		next++
+
+		stack = append(stack, v)
+		onStack[v] = true
+
+		for w := range n {
+			if w == v || d[v][w] <= d[w][v] {
+				continue
+			}
+
+			if index[w] < 0 {
+				visit(w)
+				low[v] = min(low[v], low[w])
+			} else if onStack[w] {
+				low[v] = min(low[v], index[w])
+			}
+		}
+
+		if low[v] != index[v] {
+			return
+		}
+
+		var comp []int
+
+		for {
+			w := stack[len(stack)-1]
+			stack = stack[:len(stack)-1]
+			onStack[w] = false
+			comp = append(comp, w)
+
+			if w == v {
+				break
+			}
+		}
+
+		slices.Sort(comp)
+		components = append(components, comp)
+	}
+
+	for v := range n {
+		if index[v] < 0 {
+			visit(v)
+		}
+	}
+
+	slices.SortFunc(components, func(a, b []int) int { return a[0] - b[0] })
+
+	return components
+}
+
+// shortestCycle finds the shortest chain of majority wins within group
+// that leads from its first member back to itself.
+func shortestCycle(d [][]int, group []int) []int {
+	start := group[0]
+	prev := make(map[int]int, len(group))
+	queue := []int{start}
+
+	for len(queue) > 0 {
+		v := queue[0]
+		queue = queue[1:]
+
+		for _, w := range group {
+			if w == v || d[v][w] <= d[w][v] {
+				continue
+			}
+
+			if w == start {
+				path := []int{start}
+				for u := v; u != start; u = prev[u] {
+					path = append(path, u)
+				}
+
+				path = append(path, start)
+				slices.Reverse(path)
+
+				return path
+			}
+
+			if _, seen := prev[w]; !seen {
+				prev[w] = v
+				queue = append(queue, w)
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,257 @@
+// Package ranking tallies ranked ballots with several counting methods.
+package ranking
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+)
+
+// Method is a ranked-ballot counting method.
+type Method string
+
+const (
+	Borda       Method = "borda"
+	IRV         Method = "irv"
+	Schulze     Method = "schulze"
+	Copeland    Method = "copeland"
+	RankedPairs Method = "ranked-pairs"
+	Plurality   Method = "plurality"
+)
+
+// Methods lists the supported methods in display order.
+var Methods = []Method{Borda, IRV, Schulze, Copeland, RankedPairs, Plurality}
+
+// ParseMethod parses a --method value.
+func ParseMethod(s string) (Method, error) {
+	m := Method(strings.ToLower(strings.TrimSpace(s)))
+	switch m {
+	case "":
+		return Borda, nil
+	case "rp", "tideman":
+		return RankedPairs, nil
+	case "instant-runoff":
+		return IRV, nil
+	}
+
+	if slices.Contains(Methods, m) {
+		return m, nil
+	}
+
+	names := make([]string, len(Methods))
+	for i, m := range Methods {
+		names[i] = string(m)
+	}
+
+	return "", fmt.Errorf("unknown ranking method %q (want %s)", s, strings.Join(names, ", "))
+}
+
+// ScoreLabel names the score a method produces, for table headers.
+func (m Method) ScoreLabel() string {
+	switch m {
+	case IRV:
+		return "Final votes"
+	case Schulze:
+		return "Beats"
+	case Copeland:
+		return "Copeland score"
+	case RankedPairs:
+		return "Locked wins"
+	case Plurality:
+		return "First choices"
+	default:
+		return "Score"
+	}
+}
+
+// Ballot lists option indexes from most to least preferred. Options that
+// are not listed rank equally below every listed option.
+type Ballot []int
+
+// BallotFromPositions builds a ballot from per-option positions, where
+// positions[i] is the 0-based rank given to option i or nil if unranked.
+func BallotFromPositions(positions []*int) Ballot {
+	var b Ballot
+
+	for i, p := range positions {
+		if p != nil {
+			b = append(b, i)
+		}
+	}
+
+	sort.SliceStable(b, func(x, y int) bool { return *positions[b[x]] < *positions[b[y]] })
+
+	return b
+}
+
+// WeightBallots repeats each ballot weights[i] times, so a ballot of weight
+// 3 counts as three voters under every method. Ballots without a weight
+// count once.
+func WeightBallots(ballots []Ballot, weights []int) []Ballot {
+	var out []Ballot
+
+	for i, b := range ballots {
+		w := 1
+		if i < len(weights) {
+			w = weights[i]
+		}
+
+		for range w {
+			out = append(out, b)
+		}
+	}
+
+	return out
+}
+
+// Round is one IRV counting round.
+type Round struct {
+	// Votes is the tally per option; eliminated options count 0.
+	Votes []int
+	// Eliminated lists the options eliminated after this round.
+	Eliminated []int
+	// Exhausted counts ballots with no continuing option left.
+	Exhausted int
+}
+
+// Path is a Schulze strongest path between two options.
+type Path struct {
+	From, To int
+	// Via lists the options along the path, From and To included.
+	Via []int
+	// Strength is the weakest pairwise win along the path.
+	Strength int
+	// Reverse is the strength of the strongest path from To back to From.
+	Reverse int
+}
+
+// Pair is a pairwise majority considered by ranked pairs.
+type Pair struct {
+	Winner, Loser int
+	// For and Against are the voters preferring Winner, and Loser.
+	For, Against int
+	// Locked is false when locking the pair would have created a cycle.
+	Locked bool
+}
+
+// Result is the outcome of counting ballots with one method.
+type Result struct {
+	Method Method
+	// Scores holds the method's score per option (see Method.ScoreLabel).
+	Scores []float64
+	// Order lists option indexes from first to last place.
+	Order []int
+	// Rounds is the IRV round-by-round count.
+	Rounds []Round
+	// Paths are the Schulze strongest paths from the winner to every other
+	// option.
+	Paths []Path
+	// Pairs are the ranked-pairs majorities in locking order.
+	Pairs []Pair
+}
+
+// Count tallies ballots for n options with method.
+func Count(method Method, ballots []Ballot, n int) Result {
+	switch method {
+	case IRV:
+		return countIRV(ballots, n)
+	case Schulze:
+		return countSchulze(ballots, n)
+	case Copeland:
+		return countCopeland(ballots, n)
+	case RankedPairs:
+		return countRankedPairs(ballots, n)
+	case Plurality:
+		return countPlurality(ballots, nil, n)
+	default:
+		return countBorda(ballots, nil, n)
+	}
+}
+
+// CountWeighted tallies ballots where ballot i counts weights[i] times, or
+// once without a weight. Only Borda and plurality add up weights directly,
+// so they accept fractional weights; other methods count voters and need
+// ballots repeated with WeightBallots. ok is false for those methods.
+func CountWeighted(method Method, ballots []Ballot, weights []float64, n int) (res Result, ok bool) {
+	switch method {
+	case Borda:
+		return countBorda(ballots, weights, n), true
+	case Plurality:
+		return countPlurality(ballots, weights, n), true
+	default:
+		return Result{}, false
+	}
+}
+
+// ballotWeight is the weight of ballot i, 1 when weights does not cover it.
+func ballotWeight(weights []float64, i int) float64 {
+	if i < len(weights) {
+		return weights[i]
+	}
+
+	return 1
+}
+
+// orderByScore lists options by descending score, ties in option order.
+func orderByScore(scores []float64) []int {
+	order := make([]int, len(scores))
+	for i := range order {
+		order[i] = i
+	}
+
+	sort.SliceStable(order, func(a, b int) bool { return scores[order[a]] > scores[order[b]] })
+
+	return order
+}
+
+// countBorda gives n points for a first place down to 1 for last, times
+// the ballot's weight; unranked options score nothing.
+func countBorda(ballots []Ballot, weights []float64, n int) Result {
+	scores := make([]float64, n)
+
+	for i, b := range ballots {
+		w := ballotWeight(weights, i)
+
+		for pos, opt := range b {
+			if opt < n {
+				scores[opt] += float64(n-pos) * w
+			}
+		}
+	}
+
+	return Result{Method: Borda, Scores: scores, Order: orderByScore(scores)}
+}
+
+// countPlurality counts first choices only, each by its ballot's weight.
+func countPlurality(ballots []Ballot, weights []float64, n int) Result {
+	scores := make([]float64, n)
+
+	for i, b := range ballots {
+		if len(b) > 0 && b[0] < n {
+			scores[b[0]] += ballotWeight(weights, i)
+		}
+	}
+
+	return Result{Method: Plurality, Scores: scores, Order: orderByScore(scores)}
+}
+
+// countCopeland scores one point per pairwise win and half per tie.
+func countCopeland(ballots []Ballot, n int) Result {
+	d := Pairwise(ballots, n)
+	scores := make([]float64, n)
+
+	for i := range n {
+		for j := range n {
+			switch {
+			case i == j:
+			case d[i][j] > d[j][i]:
+				scores[i]++
+			case d[i][j] == d[j][i]:
+				scores[i] += 0.5
+			}
+		}
+	}
+
+	return Result{Method: Copeland, Scores: scores, Order: orderByScore(scores)}
+}
@@ -0,0 +1,235 @@
+package ranking
+
+import (
+	"slices"
+	"testing"
+)
+
+// repeat returns count copies of b.
+func repeat(count int, b Ballot) []Ballot {
+	out := make([]Ballot, count)
+	for i := range out {
+		out[i] = b
+	}
+
+	return out
+}
+
+// tennessee is the classic capital-city election: Memphis 0, Nashville 1,
+// Chattanooga 2, Knoxville 3.
+func tennessee() []Ballot {
+	var b []Ballot
+	b = append(b, repeat(42, Ballot{0, 1, 2, 3})...)
+	b = append(b, repeat(26, Ballot{1, 2, 3, 0})...)
+	b = append(b, repeat(15, Ballot{2, 3, 1, 0})...)
+	b = append(b, repeat(17, Ballot{3, 2, 1, 0})...)
+
+	return b
+}
+
+func TestCount_Tennessee(t *testing.T) {
+	tests := []struct {
+		method Method
+		winner int
+	}{
+		{Plurality, 0},
+		{IRV, 3},
+		{Borda, 1},
+		{Copeland, 1},
+		{Schulze, 1},
+		{RankedPairs, 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.method), func(t *testing.T) {
+			res := Count(tt.method, tennessee(), 4)
+			if res.Order[0] != tt.winner {
+				t.Errorf("winner = %d, want %d (order %v, scores %v)", res.Order[0], tt.winner, res.Order, res.Scores)
+			}
+
+			if len(res.Order) != 4 {
+				t.Errorf("order %v should list every option", res.Order)
+			}
+		})
+	}
+}
+
+func TestCount_IRVRounds(t *testing.T) {
+	res := Count(IRV, tennessee(), 4)
+
+	if len(res.Rounds) != 3 {
+		t.Fatalf("rounds = %d, want 3: %+v", len(res.Rounds), res.Rounds)
+	}
+
+	if got := res.Rounds[0].Eliminated; !slices.Equal(got, []int{2}) {
+		t.Errorf("round 1 eliminated %v, want Chattanooga", got)
+	}
+
+	if got := res.Rounds[1].Eliminated; !slices.Equal(got, []int{1}) {
+		t.Errorf("round 2 eliminated %v, want Nashville", got)
+	}
+
+	if got := res.Rounds[2].Votes[3]; got != 58 {
+		t.Errorf("Knoxville final votes = %d, want 58", got)
+	}
+}
+
+func TestCount_SchulzeWikipedia(t *testing.T) {
+	// A=0 B=1 C=2 D=3 E=4; the expected ranking is E > A > C > B > D.
+	var ballots []Ballot
+	ballots = append(ballots, repeat(5, Ballot{0, 2, 1, 4, 3})...)
+	ballots = append(ballots, repeat(5, Ballot{0, 3, 4, 2, 1})...)
+	ballots = append(ballots, repeat(8, Ballot{1, 4, 3, 0, 2})...)
+	ballots = append(ballots, repeat(3, Ballot{2, 0, 1, 4, 3})...)
+	ballots = append(ballots, repeat(7, Ballot{2, 0, 4, 1, 3})...)
+	ballots = append(ballots, repeat(2, Ballot{2, 1, 0, 3, 4})...)
+	ballots = append(ballots, repeat(7, Ballot{3, 2, 4, 1, 0})...)
+	ballots = append(ballots, repeat(8, Ballot{4, 1, 0, 3, 2})...)
+
+	res := Count(Schulze, ballots, 5)
+	if want := []int{4, 0, 2, 1, 3}; !slices.Equal(res.Order, want) {
+		t.Errorf("order = %v, want %v", res.Order, want)
+	}
+
+	if len(res.Paths) != 4 {
+		t.Fatalf("paths = %+v, want one per other option", res.Paths)
+	}
+
+	for _, p := range res.Paths {
+		if p.Via[0] != 4 || p.Via[len(p.Via)-1] != p.To {
+			t.Errorf("path %+v should run from E to %d", p, p.To)
+		}
+	}
+
+	// E has no direct majority over A; its strongest path has strength 25.
+	if p := res.Paths[0]; p.To != 0 || p.Strength != 25 || len(p.Via) < 3 {
+		t.Errorf("path to A = %+v, want an indirect path of strength 25", p)
+	}
+}
+
+func TestCount_RankedPairsSkipsCycle(t *testing.T) {
+	// A > B > C, B > C > A, C > A > B with unequal counts: a cycle where
+	// the weakest majority (C over A) must be skipped.
+	var ballots []Ballot
+	ballots = append(ballots, repeat(4, Ballot{0, 1, 2})...)
+	ballots = append(ballots, repeat(3, Ballot{1, 2, 0})...)
+	ballots = append(ballots, repeat(2, Ballot{2, 0, 1})...)
+
+	res := Count(RankedPairs, ballots, 3)
+	if res.Order[0] != 0 {
+		t.Errorf("winner = %d, want A (order %v)", res.Order[0], res.Order)
+	}
+
+	skipped := 0
+
+	for _, p := range res.Pairs {
+		if !p.Locked {
+			skipped++
+
+			if p.Winner != 2 || p.Loser != 0 {
+				t.Errorf("skipped %+v, want C over A", p)
+			}
+		}
+	}
+
+	if skipped != 1 {
+		t.Errorf("skipped %d pairs, want 1", skipped)
+	}
+}
+
+func TestBallotFromPositions(t *testing.T) {
+	pos := func(v int) *int { return &v }
+
+	got := BallotFromPositions([]*int{pos(2), nil, pos(0), pos(1)})
+	if want := (Ballot{2, 3, 0}); !slices.Equal(got, want) {
+		t.Errorf("BallotFromPositions = %v, want %v", got, want)
+	}
+}
+
+func TestWeightBallots(t *testing.T) {
+	got := WeightBallots([]Ballot{{0, 1}, {1, 0}, {2}}, []int{3, 0})
+	if len(got) != 4 || got[0][0] != 0 || got[2][0] != 0 || got[3][0] != 2 {
+		t.Errorf("WeightBallots = %v, want three {0 1} and one {2}", got)
+	}
+
+	// Weighting the Tennessee minority can change the IRV winner.
+	ballots := []Ballot{{0, 1, 2, 3}, {1, 2, 3, 0}, {2, 3, 1, 0}, {3, 2, 1, 0}}
+	if res := Count(IRV, WeightBallots(ballots, []int{42, 26, 15, 17}), 4); res.Order[0] != 3 {
+		t.Errorf("weighted IRV winner = %d, want Knoxville", res.Order[0])
+	}
+}
+
+func TestCountWeighted(t *testing.T) {
+	ballots := []Ballot{{0, 1, 2}, {1, 0}, {2}}
+	weights := []float64{0.5, 2}
+
+	borda, ok := CountWeighted(Borda, ballots, weights, 3)
+	if want := []float64{1.5 + 4, 1 + 6, 0.5 + 3}; !ok || !slices.Equal(borda.Scores, want) {
+		t.Errorf("weighted Borda = %v, %v, want %v", borda.Scores, ok, want)
+	}
+
+	if want := []int{1, 0, 2}; !slices.Equal(borda.Order, want) {
+		t.Errorf("weighted Borda order = %v, want %v", borda.Order, want)
+	}
+
+	plurality, ok := CountWeighted(Plurality, ballots, weights, 3)
+	if want := []float64{0.5, 2, 1}; !ok || !slices.Equal(plurality.Scores, want) {
+		t.Errorf("weighted plurality = %v, %v, want %v", plurality.Scores, ok, want)
+	}
+
+	// Without weights every ballot counts once, as with Count.
+	if res, _ := CountWeighted(Borda, ballots, nil, 3); !slices.Equal(res.Scores, Count(Borda, ballots, 3).Scores) {
+		t.Errorf("unweighted Borda = %v", res.Scores)
+	}
+
+	if _, ok := CountWeighted(IRV, ballots, weights, 3); ok {
+		t.Error("CountWeighted(IRV) ok, want unsupported")
+	}
+}
+
+func TestPairwise_UnrankedLast(t *testing.T) {
+	d := Pairwise([]Ballot{{1}}, 3)
+
+	if d[1][0] != 1 || d[1][2] != 1 || d[0][2] != 0 || d[2][0] != 0 {
+		t.Errorf("Pairwise = %v", d)
+	}
+}
+
+func TestCondorcet_Tennessee(t *testing.T) {
+	d := Pairwise(tennessee(), 4)
+
+	if w, ok := CondorcetWinner(d); !ok || w != 1 {
+		t.Errorf("CondorcetWinner = %d, %v; want Nashville", w, ok)
+	}
+
+	if l, ok := CondorcetLoser(d); !ok || l != 0 {
+		t.Errorf("CondorcetLoser = %d, %v; want Memphis", l, ok)
+	}
+
+	if c := Cycles(d); len(c) != 0 {
+		t.Errorf("Cycles = %v, want none", c)
+	}
+}
+
+func TestCycles(t *testing.T) {
+	// A, B and C beat each other in a circle; all three beat D.
+	var ballots []Ballot
+	ballots = append(ballots, repeat(4, Ballot{0, 1, 2, 3})...)
+	ballots = append(ballots, repeat(3, Ballot{1, 2, 0, 3})...)
+	ballots = append(ballots, repeat(2, Ballot{2, 0, 1, 3})...)
+
+	d := Pairwise(ballots, 4)
+
+	if _, ok := CondorcetWinner(d); ok {
+		t.Error("CondorcetWinner found in a cycle")
+	}
+
+	if l, ok := CondorcetLoser(d); !ok || l != 3 {
+		t.Errorf("CondorcetLoser = %d, %v; want D", l, ok)
+	}
+
+	got := Cycles(d)
+	if want := [][]int{{0, 1, 2, 0}}; !slices.EqualFunc(got, want, slices.Equal) {
+		t.Errorf("Cycles = %v, want %v", got, want)
+	}
+}
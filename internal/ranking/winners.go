@@ -0,0 +1,168 @@
+package ranking
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"slices"
+	"strings"
+)
+
+// TieBreak is a rule for resolving a tie for the last winning place.
+type TieBreak string
+
+const (
+	// TieBreakNone leaves ties unresolved and reports them.
+	TieBreakNone TieBreak = "none"
+	// TieBreakFirstPreference prefers the options with more first
+	// preferences; options equal on those too stay tied.
+	TieBreakFirstPreference TieBreak = "first-preference"
+	// TieBreakRandom draws the winners with a seeded shuffle.
+	TieBreakRandom TieBreak = "random"
+	// TieBreakPosition prefers the options listed first in the poll.
+	TieBreakPosition TieBreak = "position"
+)
+
+// TieBreaks lists the supported tie-break rules in display order.
+var TieBreaks = []TieBreak{TieBreakNone, TieBreakFirstPreference, TieBreakRandom, TieBreakPosition}
+
+// ParseTieBreak parses a --tie-break value.
+func ParseTieBreak(s string) (TieBreak, error) {
+	tb := TieBreak(strings.ToLower(strings.TrimSpace(s)))
+	if tb == "" {
+		return TieBreakNone, nil
+	}
+
+	if slices.Contains(TieBreaks, tb) {
+		return tb, nil
+	}
+
+	names := make([]string, len(TieBreaks))
+	for i, t := range TieBreaks {
+		names[i] = string(t)
+	}
+
+	return "", fmt.Errorf("unknown tie-break %q (want %s)", s, strings.Join(names, ", "))
+}
+
+// TieBreaker configures how Winners resolves a tie.
+type TieBreaker struct {
+	Rule TieBreak
+	// FirstPreferences holds each option's first-preference count, for
+	// TieBreakFirstPreference.
+	FirstPreferences []float64
+	// Seed seeds TieBreakRandom, so a draw can be repeated.
+	Seed uint64
+}
+
+// Tie is a tie for the last winning places.
+type Tie struct {
+	// Options are the tied options, in poll order.
+	Options []int
+	// Seats is the number of winning places the tied options compete for.
+	Seats int
+	// Broken reports whether the tie-break rule decided the tie. When
+	// false, none of Options is among the winners.
+	Broken bool
+}
+
+// Outcome is the set of winners picked from a score per option.
+type Outcome struct {
+	// Winners lists the decided winners, best first.
+	Winners []int
+	// Tie is the tie for the last winning places, if there was one.
+	Tie *Tie
+}
+
+// Winners picks the k highest-scoring options. Options sharing the score
+// of the last winning place with options outside it form a tie, resolved
+// by tb when possible. When no option scores above zero, as in a poll
+// without votes, there is no winner yet rather than a tie.
+func Winners(scores []float64, k int, tb TieBreaker) Outcome {
+	if !slices.ContainsFunc(scores, func(s float64) bool { return s > 0 }) {
+		return Outcome{}
+	}
+
+	return pick(scores, k, tb)
+}
+
+// pick is Winners without the no-votes check, so equal zero scores among
+// tied options still tie.
+func pick(scores []float64, k int, tb TieBreaker) Outcome {
+	n := len(scores)
+	order := orderByScore(scores)
+	k = max(k, 1)
+
+	if k >= n {
+		return Outcome{Winners: order}
+	}
+
+	cutoff := scores[order[k-1]]
+	if scores[order[k]] != cutoff {
+		return Outcome{Winners: order[:k]}
+	}
+
+	var winners, tied []int
+
+	for _, i := range order {
+		if scores[i] > cutoff {
+			winners = append(winners, i)
+		}
+	}
+
+	for i, s := range scores {
+		if s == cutoff {
+			tied = append(tied, i)
+		}
+	}
+
+	tie := &Tie{Options: tied, Seats: k - len(winners)}
+
+	switch tb.Rule {
+	case TieBreakPosition:
+		winners = append(winners, tied[:tie.Seats]...)
+		tie.Broken = true
+	case TieBreakRandom:
+		drawn := slices.Clone(tied)
+		r := rand.New(rand.NewPCG(tb.Seed, 0))
+		r.Shuffle(len(drawn), func(a, b int) { drawn[a], drawn[b] = drawn[b], drawn[a] })
+
+		winners = append(winners, drawn[:tie.Seats]...)
+		tie.Broken = true
+	case TieBreakFirstPreference:
+		if len(tb.FirstPreferences) != n {
+			break
+		}
+
+		sub := make([]float64, len(tied))
+		for j, i := range tied {
+			sub[j] = tb.FirstPreferences[i]
+		}
+
+		inner := pick(sub, tie.Seats, TieBreaker{Rule: TieBreakNone})
+		for _, j := range inner.Winners {
+			winners = append(winners, tied[j])
+		}
+
+		if inner.Tie == nil {
+			tie.Broken = true
+
+			break
+		}
+
+		// Options ahead on first preferences win outright; the tie
+		// narrows to those still level.
+		tie.Options = make([]int, len(inner.Tie.Options))
+		for m, j := range inner.Tie.Options {
+			tie.Options[m] = tied[j]
+		}
+
+		tie.Seats = inner.Tie.Seats
+	}
+
+	return Outcome{Winners: winners, Tie: tie}
+}
+
+// Unresolved reports whether the outcome leaves winning places undecided.
+func (o Outcome) Unresolved() bool {
+	return o.Tie != nil && !o.Tie.Broken
+}
@@ -0,0 +1,101 @@
+package ranking
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestWinners(t *testing.T) {
+	tests := []struct {
+		name       string
+		scores     []float64
+		k          int
+		tb         TieBreaker
+		winners    []int
+		tied       []int
+		seats      int
+		broken     bool
+		unresolved bool
+	}{
+		{name: "clear winner", scores: []float64{3, 5, 1}, k: 1, winners: []int{1}},
+		{name: "two winners", scores: []float64{3, 5, 1}, k: 2, winners: []int{1, 0}},
+		{name: "all win", scores: []float64{1, 1}, k: 3, winners: []int{0, 1}},
+		{name: "zero means one", scores: []float64{1, 2}, k: 0, winners: []int{1}},
+		{name: "no votes", scores: []float64{0, 0, 0}, k: 1},
+		{name: "no options", scores: nil, k: 1},
+		{name: "tie within winners", scores: []float64{5, 5, 1}, k: 2, winners: []int{0, 1}},
+		{
+			name: "unresolved tie", scores: []float64{5, 3, 5, 1}, k: 1,
+			winners: nil, tied: []int{0, 2}, seats: 1, unresolved: true,
+		},
+		{
+			name: "tie for second seat", scores: []float64{2, 5, 2, 2}, k: 2,
+			winners: []int{1}, tied: []int{0, 2, 3}, seats: 1, unresolved: true,
+		},
+		{
+			name: "position", scores: []float64{2, 5, 2, 2}, k: 3, tb: TieBreaker{Rule: TieBreakPosition},
+			winners: []int{1, 0, 2}, tied: []int{0, 2, 3}, seats: 2, broken: true,
+		},
+		{
+			name: "first preference", scores: []float64{4, 4, 4}, k: 1,
+			tb:      TieBreaker{Rule: TieBreakFirstPreference, FirstPreferences: []float64{1, 3, 2}},
+			winners: []int{1}, tied: []int{0, 1, 2}, seats: 1, broken: true,
+		},
+		{
+			name: "no first preferences", scores: []float64{4, 4}, k: 1,
+			tb:      TieBreaker{Rule: TieBreakFirstPreference, FirstPreferences: []float64{0, 0}},
+			winners: nil, tied: []int{0, 1}, seats: 1, unresolved: true,
+		},
+		{
+			name: "first preference narrows", scores: []float64{4, 4, 4}, k: 1,
+			tb:      TieBreaker{Rule: TieBreakFirstPreference, FirstPreferences: []float64{1, 2, 2}},
+			winners: nil, tied: []int{1, 2}, seats: 1, unresolved: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Winners(tt.scores, tt.k, tt.tb)
+
+			if !slices.Equal(got.Winners, tt.winners) {
+				t.Errorf("Winners = %v, want %v", got.Winners, tt.winners)
+			}
+
+			if got.Unresolved() != tt.unresolved {
+				t.Errorf("Unresolved = %v, want %v", got.Unresolved(), tt.unresolved)
+			}
+
+			if tt.tied == nil {
+				if got.Tie != nil {
+					t.Errorf("Tie = %+v, want none", got.Tie)
+				}
+
+				return
+			}
+
+			if got.Tie == nil {
+				t.Fatal("Tie = nil")
+			}
+
+			if !slices.Equal(got.Tie.Options, tt.tied) || got.Tie.Seats != tt.seats || got.Tie.Broken != tt.broken {
+				t.Errorf("Tie = %+v, want options %v seats %d broken %v", got.Tie, tt.tied, tt.seats, tt.broken)
+			}
+		})
+	}
+}
+
+func TestWinners_RandomSeed(t *testing.T) {
+	scores := []float64{1, 1, 1, 1, 1, 1}
+	tb := TieBreaker{Rule: TieBreakRandom, Seed: 42}
+
+	a := Winners(scores, 2, tb)
+	b := Winners(scores, 2, tb)
+
+	if len(a.Winners) != 2 || !slices.Equal(a.Winners, b.Winners) {
+		t.Errorf("same seed drew %v and %v", a.Winners, b.Winners)
+	}
+
+	if !a.Tie.Broken {
+		t.Error("random tie-break left the tie unresolved")
+	}
+}
@@ -0,0 +1,251 @@
+// Package snapshot stores poll results on disk so they can be compared
+// with later results.
+package snapshot
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/dedene/strawpoll-cli/internal/api"
+)
+
+// nameLayout names snapshot files after the time they were taken, so
+// names sort chronologically. Snapshots taken within the same second get a
+// "-2", "-3", ... suffix.
+const nameLayout = "20060102T150405Z"
+
+// ErrNotFound is returned when no snapshot matches.
+var ErrNotFound = errors.New("snapshot not found")
+
+// Snapshot is a poll's results at one point in time.
+type Snapshot struct {
+	PollID  string           `json:"pollId"`
+	TakenAt time.Time        `json:"takenAt"`
+	Results *api.PollResults `json:"results"`
+
+	// name is set once the snapshot is saved or loaded.
+	name string
+}
+
+// Name identifies the snapshot among those of its poll.
+func (s *Snapshot) Name() string {
+	if s.name != "" {
+		return s.name
+	}
+
+	return s.TakenAt.UTC().Format(nameLayout)
+}
+
+// Entry describes a stored snapshot without loading it.
+type Entry struct {
+	Name    string    `json:"name"`
+	TakenAt time.Time `json:"takenAt"`
+	Path    string    `json:"path"`
+
+	// seq orders snapshots taken within the same second.
+	seq int
+}
+
+// Store keeps snapshots in one directory per poll under Dir.
+type Store struct {
+	Dir string
+}
+
+func (st *Store) pollDir(pollID string) string {
+	return filepath.Join(st.Dir, filepath.Base(pollID))
+}
+
+// Save writes s and returns its path. A snapshot taken in the same second
+// as an earlier one is saved under a suffixed name rather than replacing
+// it.
+func (st *Store) Save(s *Snapshot) (string, error) {
+	dir := st.pollDir(s.PollID)
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return "", fmt.Errorf("ensure snapshot dir: %w", err)
+	}
+
+	b, err := json.MarshalIndent(s, "", "  ")
+	if err != nil {
+		return "", fmt.Errorf("encode snapshot: %w", err)
+	}
+
+	base := s.TakenAt.UTC().Format(nameLayout)
+	tmp := filepath.Join(dir, base+".json.tmp")
+
+	if err := os.WriteFile(tmp, b, 0o600); err != nil {
+		return "", fmt.Errorf("write snapshot: %w", err)
+	}
+	defer os.Remove(tmp)
+
+	// Linking fails when the name is taken, unlike a rename, so an
+	// existing snapshot is never replaced.
+	for seq := 1; ; seq++ {
+		name := base
+		if seq > 1 {
+			name += "-" + strconv.Itoa(seq)
+		}
+
+		path := filepath.Join(dir, name+".json")
+
+		err := os.Link(tmp, path)
+		if errors.Is(err, os.ErrExist) {
+			continue
+		}
+
+		if err != nil {
+			return "", fmt.Errorf("commit snapshot: %w", err)
+		}
+
+		s.name = name
+
+		return path, nil
+	}
+}
+
+// List returns the snapshots of a poll, oldest first.
+func (st *Store) List(pollID string) ([]Entry, error) {
+	dir := st.pollDir(pollID)
+
+	files, err := os.ReadDir(dir)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("read snapshot dir: %w", err)
+	}
+
+	var entries []Entry
+
+	for _, f := range files {
+		name, ok := strings.CutSuffix(f.Name(), ".json")
+		if !ok || f.IsDir() {
+			continue
+		}
+
+		t, seq, ok := parseName(name)
+		if !ok {
+			continue
+		}
+
+		entries = append(entries, Entry{Name: name, TakenAt: t, Path: filepath.Join(dir, f.Name()), seq: seq})
+	}
+
+	sort.Slice(entries, func(i, j int) bool {
+		if !entries[i].TakenAt.Equal(entries[j].TakenAt) {
+			return entries[i].TakenAt.Before(entries[j].TakenAt)
+		}
+
+		return entries[i].seq < entries[j].seq
+	})
+
+	return entries, nil
+}
+
+// parseName parses a snapshot name into the time it was taken and its
+// sequence number within that second, 1 for an unsuffixed name.
+func parseName(name string) (time.Time, int, bool) {
+	stamp, suffix, found := strings.Cut(name, "-")
+
+	t, err := time.Parse(nameLayout, stamp)
+	if err != nil {
+		return time.Time{}, 0, false
+	}
+
+	if !found {
+		return t, 1, true
+	}
+
+	seq, err := strconv.Atoi(suffix)
+	if err != nil || seq < 2 {
+		return time.Time{}, 0, false
+	}
+
+	return t, seq, true
+}
+
+// Load reads a stored snapshot.
+func (st *Store) Load(e Entry) (*Snapshot, error) {
+	b, err := os.ReadFile(e.Path)
+	if err != nil {
+		return nil, fmt.Errorf("read snapshot: %w", err)
+	}
+
+	var s Snapshot
+	if err := json.Unmarshal(b, &s); err != nil {
+		return nil, fmt.Errorf("parse snapshot %s: %w", e.Name, err)
+	}
+
+	s.name = e.Name
+
+	return &s, nil
+}
+
+// Find picks a snapshot of a poll. since is empty for the latest snapshot,
+// a duration such as "24h" or "2d" for the latest snapshot at least that
+// old, or a snapshot name or unique name prefix.
+func (st *Store) Find(pollID, since string, now time.Time) (Entry, error) {
+	entries, err := st.List(pollID)
+	if err != nil {
+		return Entry{}, err
+	}
+
+	if len(entries) == 0 {
+		return Entry{}, fmt.Errorf("%w: no snapshots of %s", ErrNotFound, pollID)
+	}
+
+	if since == "" {
+		return entries[len(entries)-1], nil
+	}
+
+	if d, err := ParseAge(since); err == nil {
+		cutoff := now.Add(-d)
+		for i := len(entries) - 1; i >= 0; i-- {
+			if !entries[i].TakenAt.After(cutoff) {
+				return entries[i], nil
+			}
+		}
+
+		return Entry{}, fmt.Errorf("%w: no snapshot of %s older than %s", ErrNotFound, pollID, since)
+	}
+
+	var matches []Entry
+
+	for _, e := range entries {
+		if e.Name == since {
+			return e, nil
+		}
+
+		if strings.HasPrefix(e.Name, since) {
+			matches = append(matches, e)
+		}
+	}
+
+	switch len(matches) {
+	case 0:
+		return Entry{}, fmt.Errorf("%w: %s has no snapshot %q", ErrNotFound, pollID, since)
+	case 1:
+		return matches[0], nil
+	default:
+		return Entry{}, fmt.Errorf("snapshot %q is ambiguous: %d snapshots of %s match", since, len(matches), pollID)
+	}
+}
+
+// ParseAge parses a duration, also accepting whole days such as "2d".
+func ParseAge(s string) (time.Duration, error) {
+	if days, ok := strings.CutSuffix(s, "d"); ok {
+		var n int
+		if _, err := fmt.Sscanf(days, "%d", &n); err == nil && n >= 0 && fmt.Sprint(n) == days {
+			return time.Duration(n) * 24 * time.Hour, nil
+		}
+	}
+
+	return time.ParseDuration(s)
+}
@@ -0,0 +1,144 @@
+package snapshot
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/dedene/strawpoll-cli/internal/api"
+)
+
+func TestStore_SaveFind(t *testing.T) {
+	st := &Store{Dir: t.TempDir()}
+	base := time.Date(2026, 10, 16, 9, 0, 0, 0, time.UTC)
+
+	for i, votes := range []int{3, 5, 8} {
+		s := &Snapshot{
+			PollID:  "abc",
+			TakenAt: base.Add(time.Duration(i) * 24 * time.Hour),
+			Results: &api.PollResults{ID: "abc", VoteCount: votes},
+		}
+
+		if _, err := st.Save(s); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	now := base.Add(48*time.Hour + time.Hour)
+
+	tests := []struct {
+		since string
+		votes int
+	}{
+		{"", 8},
+		{"24h", 5},
+		{"1d", 5},
+		{"30h", 3},
+		{"20261016T090000Z", 3},
+		{"20261017", 5},
+	}
+
+	for _, tt := range tests {
+		e, err := st.Find("abc", tt.since, now)
+		if err != nil {
+			t.Errorf("Find(%q) error: %v", tt.since, err)
+
+			continue
+		}
+
+		s, err := st.Load(e)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if s.Results.VoteCount != tt.votes {
+			t.Errorf("Find(%q) = %s with %d votes, want %d", tt.since, e.Name, s.Results.VoteCount, tt.votes)
+		}
+	}
+
+	for _, since := range []string{"72h", "2025", "2026101"} {
+		if _, err := st.Find("abc", since, now); err == nil {
+			t.Errorf("Find(%q): want error", since)
+		}
+	}
+
+	if _, err := st.Find("other", "", now); !errors.Is(err, ErrNotFound) {
+		t.Errorf("Find(other) error = %v, want ErrNotFound", err)
+	}
+}
+
+func TestStore_SaveSameSecond(t *testing.T) {
+	st := &Store{Dir: t.TempDir()}
+	taken := time.Date(2026, 10, 16, 9, 0, 0, 0, time.UTC)
+
+	var names []string
+
+	for i := range 11 {
+		s := &Snapshot{
+			PollID:  "abc",
+			TakenAt: taken.Add(time.Duration(i) * time.Millisecond),
+			Results: &api.PollResults{ID: "abc", VoteCount: i},
+		}
+
+		if _, err := st.Save(s); err != nil {
+			t.Fatal(err)
+		}
+
+		names = append(names, s.Name())
+	}
+
+	if names[0] != "20261016T090000Z" || names[1] != "20261016T090000Z-2" || names[10] != "20261016T090000Z-11" {
+		t.Errorf("names = %v", names)
+	}
+
+	entries, err := st.List("abc")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(entries) != len(names) {
+		t.Fatalf("List = %d entries, want %d", len(entries), len(names))
+	}
+
+	for i, e := range entries {
+		if e.Name != names[i] {
+			t.Errorf("List[%d] = %s, want %s", i, e.Name, names[i])
+		}
+	}
+
+	for since, votes := range map[string]int{"": 10, "20261016T090000Z": 0, "20261016T090000Z-2": 1} {
+		e, err := st.Find("abc", since, taken)
+		if err != nil {
+			t.Fatalf("Find(%q) error: %v", since, err)
+		}
+
+		s, err := st.Load(e)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if s.Results.VoteCount != votes || s.Name() != e.Name {
+			t.Errorf("Find(%q) = %s with %d votes, want %d", since, s.Name(), s.Results.VoteCount, votes)
+		}
+	}
+}
+
+func TestParseAge(t *testing.T) {
+	tests := []struct {
+		in   string
+		want time.Duration
+		err  bool
+	}{
+		{"90m", 90 * time.Minute, false},
+		{"2d", 48 * time.Hour, false},
+		{"d", 0, true},
+		{"1.5d", 0, true},
+	}
+
+	for _, tt := range tests {
+		got, err := ParseAge(tt.in)
+		if (err != nil) != tt.err || got != tt.want {
+			t.Errorf("ParseAge(%q) = %v, %v", tt.in, got, err)
+		}
+	}
+}
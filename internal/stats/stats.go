@@ -0,0 +1,56 @@
+// Package stats provides the confidence intervals and significance tests
+// used to qualify poll results.
+package stats
+
+import "math"
+
+// Critical returns the two-sided critical value of the standard normal
+// distribution for significance level alpha, e.g. 1.96 for 0.05.
+func Critical(alpha float64) float64 {
+	return math.Sqrt2 * math.Erfinv(1-alpha)
+}
+
+// Wilson returns the Wilson score interval for a proportion of successes
+// out of n trials, at significance level alpha. Both bounds are 0 when n
+// is 0.
+func Wilson(successes, n int, alpha float64) (lower, upper float64) {
+	if n <= 0 {
+		return 0, 0
+	}
+
+	z := Critical(alpha)
+	z2 := z * z
+	nf := float64(n)
+	p := float64(successes) / nf
+
+	center := (p + z2/(2*nf)) / (1 + z2/nf)
+	margin := z / (1 + z2/nf) * math.Sqrt(p*(1-p)/nf+z2/(4*nf*nf))
+
+	return math.Max(0, center-margin), math.Min(1, center+margin)
+}
+
+// Lead is a significance test of the gap between two options.
+type Lead struct {
+	// Z is the test statistic; PValue its two-sided p-value.
+	Z      float64
+	PValue float64
+	// Significant reports whether PValue is below the test's alpha.
+	Significant bool
+}
+
+// TestLead tests whether the first of two options is preferred by more
+// voters than the second. onlyFirst and onlySecond count the voters who
+// chose one option but not the other; voters who chose both or neither
+// carry no information about the gap (McNemar's test). In a single-choice
+// poll they are simply the two options' vote counts.
+func TestLead(onlyFirst, onlySecond int, alpha float64) Lead {
+	discordant := onlyFirst + onlySecond
+	if discordant == 0 {
+		return Lead{PValue: 1}
+	}
+
+	z := float64(onlyFirst-onlySecond) / math.Sqrt(float64(discordant))
+	p := math.Erfc(math.Abs(z) / math.Sqrt2)
+
+	return Lead{Z: z, PValue: p, Significant: p < alpha}
+}
@@ -0,0 +1,73 @@
+package stats
+
+import (
+	"math"
+	"testing"
+)
+
+func near(a, b, tol float64) bool {
+	return math.Abs(a-b) <= tol
+}
+
+func TestCritical(t *testing.T) {
+	tests := []struct {
+		alpha, want float64
+	}{
+		{0.05, 1.95996},
+		{0.01, 2.57583},
+		{0.1, 1.64485},
+	}
+
+	for _, tt := range tests {
+		if got := Critical(tt.alpha); !near(got, tt.want, 1e-4) {
+			t.Errorf("Critical(%v) = %v, want %v", tt.alpha, got, tt.want)
+		}
+	}
+}
+
+func TestWilson(t *testing.T) {
+	tests := []struct {
+		name         string
+		k, n         int
+		lower, upper float64
+	}{
+		{"half", 50, 100, 0.40383, 0.59617},
+		{"none", 0, 10, 0, 0.27753},
+		{"all", 10, 10, 0.72247, 1},
+		{"empty", 0, 0, 0, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			lo, hi := Wilson(tt.k, tt.n, 0.05)
+			if !near(lo, tt.lower, 1e-4) || !near(hi, tt.upper, 1e-4) {
+				t.Errorf("Wilson(%d, %d) = [%v, %v], want [%v, %v]", tt.k, tt.n, lo, hi, tt.lower, tt.upper)
+			}
+		})
+	}
+}
+
+func TestTestLead(t *testing.T) {
+	tests := []struct {
+		name        string
+		a, b        int
+		significant bool
+	}{
+		{"close", 12, 9, false},
+		{"clear", 40, 15, true},
+		{"no votes", 0, 0, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := TestLead(tt.a, tt.b, 0.05)
+			if got.Significant != tt.significant {
+				t.Errorf("TestLead(%d, %d) = %+v, want significant %v", tt.a, tt.b, got, tt.significant)
+			}
+		})
+	}
+
+	if got := TestLead(40, 15, 0.05); !near(got.Z, 3.37100, 1e-4) {
+		t.Errorf("TestLead(40, 15).Z = %v", got.Z)
+	}
+}
@@ -0,0 +1,208 @@
+// Package theme defines the color themes shared by table output and the
+// interactive TUI.
+package theme
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+	"sync"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Default is the name of the theme used when none is configured.
+const Default = "default"
+
+// Color is a terminal color that may differ between light and dark
+// backgrounds. Values are lipgloss colors: ANSI ("2"), 256-color ("245")
+// or hex ("#60a5fa"). In YAML a single string sets both variants.
+type Color struct {
+	Light string `yaml:"light,omitempty" json:"light,omitempty"`
+	Dark  string `yaml:"dark,omitempty" json:"dark,omitempty"`
+}
+
+// Same returns a Color that is identical on light and dark backgrounds.
+func Same(c string) Color {
+	return Color{Light: c, Dark: c}
+}
+
+// IsZero reports whether neither variant is set.
+func (c Color) IsZero() bool {
+	return c.Light == "" && c.Dark == ""
+}
+
+// Pick returns the variant for the background.
+func (c Color) Pick(dark bool) string {
+	if dark {
+		return c.Dark
+	}
+
+	return c.Light
+}
+
+// UnmarshalYAML accepts either a color string or a light/dark mapping.
+func (c *Color) UnmarshalYAML(node *yaml.Node) error {
+	if node.Kind == yaml.ScalarNode {
+		*c = Same(node.Value)
+
+		return nil
+	}
+
+	type plain Color
+
+	return node.Decode((*plain)(c))
+}
+
+// Theme is a named set of colors. An empty Muted color renders dim text
+// as faint instead.
+type Theme struct {
+	// Base names the built-in theme a custom theme starts from.
+	Base string `yaml:"base,omitempty" json:"base,omitempty"`
+
+	// Header colors table headers and titles.
+	Header Color `yaml:"header,omitempty" json:"header,omitempty"`
+	// Accent colors bars and the focused element in forms.
+	Accent Color `yaml:"accent,omitempty" json:"accent,omitempty"`
+	// Success marks leaders, gains and selected options.
+	Success Color `yaml:"success,omitempty" json:"success,omitempty"`
+	// Error marks failures and losses.
+	Error Color `yaml:"error,omitempty" json:"error,omitempty"`
+	// Warning marks warnings and paused states.
+	Warning Color `yaml:"warning,omitempty" json:"warning,omitempty"`
+	// Muted colors secondary text and alternate table rows.
+	Muted Color `yaml:"muted,omitempty" json:"muted,omitempty"`
+}
+
+// Palette is a theme resolved for one background.
+type Palette struct {
+	Header, Accent, Success, Error, Warning, Muted string
+}
+
+// Palette resolves the theme for a dark or light background.
+func (t Theme) Palette(dark bool) Palette {
+	return Palette{
+		Header:  t.Header.Pick(dark),
+		Accent:  t.Accent.Pick(dark),
+		Success: t.Success.Pick(dark),
+		Error:   t.Error.Pick(dark),
+		Warning: t.Warning.Pick(dark),
+		Muted:   t.Muted.Pick(dark),
+	}
+}
+
+// overlay returns t with every color set in o replaced.
+func (t Theme) overlay(o Theme) Theme {
+	for _, c := range []struct{ dst, src *Color }{
+		{&t.Header, &o.Header}, {&t.Accent, &o.Accent}, {&t.Success, &o.Success},
+		{&t.Error, &o.Error}, {&t.Warning, &o.Warning}, {&t.Muted, &o.Muted},
+	} {
+		if !c.src.IsZero() {
+			*c.dst = *c.src
+		}
+	}
+
+	return t
+}
+
+// builtins are the themes that ship with the CLI.
+var builtins = map[string]Theme{
+	// default keeps the original blue headers; dim text is faint on dark
+	// backgrounds and a readable gray on light ones.
+	Default: {
+		Header:  Color{Light: "#1d4ed8", Dark: "#60a5fa"},
+		Accent:  Color{Light: "#1d4ed8", Dark: "#60a5fa"},
+		Success: Same("2"),
+		Error:   Same("1"),
+		Warning: Color{Light: "#a16207", Dark: "3"},
+		Muted:   Color{Light: "#4b5563"},
+	},
+	// high-contrast avoids dim text entirely and uses the strongest colors.
+	"high-contrast": {
+		Header:  Color{Light: "#000080", Dark: "#ffff00"},
+		Accent:  Color{Light: "#000080", Dark: "#00ffff"},
+		Success: Color{Light: "#005f00", Dark: "#00ff00"},
+		Error:   Color{Light: "#af0000", Dark: "#ff5f5f"},
+		Warning: Color{Light: "#5f005f", Dark: "#ffaf00"},
+		Muted:   Color{Light: "#000000", Dark: "#ffffff"},
+	},
+	"solarized": {
+		Header:  Same("#268bd2"),
+		Accent:  Same("#2aa198"),
+		Success: Same("#859900"),
+		Error:   Same("#dc322f"),
+		Warning: Same("#b58900"),
+		Muted:   Color{Light: "#657b83", Dark: "#93a1a1"},
+	},
+	// colorblind-safe uses the Okabe-Ito palette, which keeps success and
+	// error distinguishable for red-green color blindness.
+	"colorblind-safe": {
+		Header:  Color{Light: "#0072b2", Dark: "#56b4e9"},
+		Accent:  Color{Light: "#0072b2", Dark: "#56b4e9"},
+		Success: Color{Light: "#0072b2", Dark: "#56b4e9"},
+		Error:   Color{Light: "#d55e00", Dark: "#e69f00"},
+		Warning: Color{Light: "#cc79a7", Dark: "#f0e442"},
+		Muted:   Color{Light: "#4b5563", Dark: "#9ca3af"},
+	},
+}
+
+// Names lists the built-in theme names, default first.
+func Names() []string {
+	names := slices.Sorted(maps.Keys(builtins))
+	names = slices.DeleteFunc(names, func(n string) bool { return n == Default })
+
+	return append([]string{Default}, names...)
+}
+
+// Lookup resolves a theme by name from the built-ins and the custom themes.
+// Custom themes start from their base (default unless set) and override the
+// colors they define. An empty name selects the default theme.
+func Lookup(name string, custom map[string]Theme) (Theme, error) {
+	if name == "" {
+		name = Default
+	}
+
+	if c, ok := custom[name]; ok {
+		base := c.Base
+		if base == "" {
+			base = Default
+		}
+
+		b, ok := builtins[base]
+		if !ok {
+			return Theme{}, fmt.Errorf("theme %s: unknown base theme %q (built-in themes: %s)", name, base, strings.Join(Names(), ", "))
+		}
+
+		return b.overlay(c), nil
+	}
+
+	if t, ok := builtins[name]; ok {
+		return t, nil
+	}
+
+	available := append(Names(), slices.Sorted(maps.Keys(custom))...)
+
+	return Theme{}, fmt.Errorf("unknown theme %q (available: %s)", name, strings.Join(available, ", "))
+}
+
+var (
+	mu      sync.RWMutex
+	current = builtins[Default]
+)
+
+// Current returns the active theme.
+func Current() Theme {
+	mu.RLock()
+	defer mu.RUnlock()
+
+	return current
+}
+
+// Set makes t the active theme.
+func Set(t Theme) {
+	mu.Lock()
+	defer mu.Unlock()
+
+	current = t
+}
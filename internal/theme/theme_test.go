@@ -0,0 +1,60 @@
+package theme
+
+import (
+	"strings"
+	"testing"
+
+	"gopkg.in/yaml.v3"
+)
+
+func TestLookup(t *testing.T) {
+	for _, name := range Names() {
+		if _, err := Lookup(name, nil); err != nil {
+			t.Errorf("Lookup(%q): %v", name, err)
+		}
+	}
+
+	got, err := Lookup("", nil)
+	if err != nil || got != builtins[Default] {
+		t.Errorf("Lookup(\"\") = %+v, %v; want default theme", got, err)
+	}
+
+	if _, err := Lookup("neon", nil); err == nil || !strings.Contains(err.Error(), "high-contrast") {
+		t.Errorf("Lookup(neon) error = %v, want unknown theme listing built-ins", err)
+	}
+}
+
+func TestLookup_Custom(t *testing.T) {
+	var custom map[string]Theme
+
+	err := yaml.Unmarshal([]byte(`
+mine:
+  base: solarized
+  header: "#ff8800"
+  muted:
+    light: "#555555"
+    dark: "#aaaaaa"
+broken:
+  base: nope
+`), &custom)
+	if err != nil {
+		t.Fatalf("unmarshal: %v", err)
+	}
+
+	got, err := Lookup("mine", custom)
+	if err != nil {
+		t.Fatalf("Lookup(mine): %v", err)
+	}
+
+	if got.Header != Same("#ff8800") {
+		t.Errorf("header = %+v, want #ff8800 on both backgrounds", got.Header)
+	}
+
+	if p := got.Palette(false); p.Muted != "#555555" || p.Error != builtins["solarized"].Error.Light {
+		t.Errorf("light palette = %+v, want custom muted and solarized error", p)
+	}
+
+	if _, err := Lookup("broken", custom); err == nil {
+		t.Error("expected unknown base error")
+	}
+}
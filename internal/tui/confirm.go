@@ -2,9 +2,7 @@ package tui
 
 import (
 	"fmt"
-	"os"
 
-	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/huh"
 )
 
@@ -24,9 +22,7 @@ func Confirm(prompt string) (bool, error) {
 		Negative("No").
 		Value(&confirmed)
 
-	err := huh.NewForm(huh.NewGroup(confirm)).
-		WithProgramOptions(tea.WithOutput(os.Stderr)).
-		Run()
+	err := setupForm(huh.NewForm(huh.NewGroup(confirm))).Run()
 	if err != nil {
 		return false, err
 	}
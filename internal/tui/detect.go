@@ -10,3 +10,22 @@ import (
 func IsInteractive() bool {
 	return term.IsTerminal(int(os.Stdin.Fd()))
 }
+
+// IsOutputTerminal returns true when stdout is a terminal.
+func IsOutputTerminal() bool {
+	return term.IsTerminal(int(os.Stdout.Fd()))
+}
+
+// accessible switches forms to huh's accessible mode; see SetAccessible.
+var accessible bool
+
+// SetAccessible switches prompts and wizards to line-based questions that
+// screen readers can follow, instead of redrawn full-screen forms.
+func SetAccessible(on bool) {
+	accessible = on
+}
+
+// Accessible reports whether accessible prompts are enabled.
+func Accessible() bool {
+	return accessible
+}
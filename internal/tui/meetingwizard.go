@@ -1,12 +1,11 @@
 package tui
 
 import (
+	"errors"
 	"fmt"
-	"os"
 	"strings"
 	"time"
 
-	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/huh"
 )
 
@@ -21,6 +20,8 @@ type MeetingWizardResult struct {
 	Dupcheck    string
 }
 
+var errNoMeetingOptions = errors.New("provide at least one date or time range")
+
 // RunMeetingWizard launches the interactive meeting creation wizard.
 // Renders on stderr; returns collected inputs for the meeting create flow.
 func RunMeetingWizard() (*MeetingWizardResult, error) {
@@ -34,7 +35,7 @@ func RunMeetingWizard() (*MeetingWizardResult, error) {
 		dupcheck    = "none"
 	)
 
-	form := huh.NewForm(
+	form := setupForm(huh.NewForm(
 		// Group 1: Date/Time Selection
 		huh.NewGroup(
 			huh.NewText().
@@ -49,7 +50,15 @@ func RunMeetingWizard() (*MeetingWizardResult, error) {
 				Placeholder("2025-03-01 10:00-11:00\n2025-03-01 14:00-15:00").
 				Lines(5).
 				Value(&rangesText).
-				Validate(validateRangeLines),
+				Validate(func(s string) error {
+					// Checked here so an empty poll is caught before the
+					// remaining groups.
+					if len(parseLinesNonEmpty(datesText)) == 0 && len(parseLinesNonEmpty(s)) == 0 {
+						return errNoMeetingOptions
+					}
+
+					return validateRangeLines(s)
+				}),
 		).Title("Date & Time Options"),
 
 		// Group 2: Timezone, Location, Description
@@ -98,16 +107,18 @@ func RunMeetingWizard() (*MeetingWizardResult, error) {
 				).
 				Value(&dupcheck),
 		).Title("Settings"),
-	).WithProgramOptions(tea.WithOutput(os.Stderr))
+	))
 
 	if err := form.Run(); err != nil {
 		return nil, err
 	}
 
 	if len(parseLinesNonEmpty(datesText)) == 0 && len(parseLinesNonEmpty(rangesText)) == 0 {
-		return nil, fmt.Errorf("provide at least one date or time range")
+		return nil, errNoMeetingOptions
 	}
 
+	// Option count and time-range ordering are checked by
+	// api.ValidateCreatePollRequest once the request is built.
 	return &MeetingWizardResult{
 		Dates:       parseLinesNonEmpty(datesText),
 		TimeRanges:  parseLinesNonEmpty(rangesText),
@@ -1,12 +1,11 @@
 package tui
 
 import (
-	"fmt"
-	"os"
 	"strings"
 
-	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/huh"
+
+	"github.com/dedene/strawpoll-cli/internal/api"
 )
 
 // PollWizardResult holds the collected values from the poll creation wizard.
@@ -37,7 +36,7 @@ func RunPollWizard() (*PollWizardResult, error) {
 	dupcheck = "ip"
 	resultsVis = "always"
 
-	form := huh.NewForm(
+	form := setupForm(huh.NewForm(
 		// Group 1: Title
 		huh.NewGroup(
 			huh.NewInput().
@@ -84,7 +83,7 @@ func RunPollWizard() (*PollWizardResult, error) {
 				Title("Allow comments?").
 				Value(&allowComments),
 		),
-	).WithProgramOptions(tea.WithOutput(os.Stderr))
+	))
 
 	if err := form.Run(); err != nil {
 		return nil, err
@@ -103,18 +102,17 @@ func RunPollWizard() (*PollWizardResult, error) {
 	}, nil
 }
 
-// validateOptions ensures at least 2 non-empty lines and at most 30.
+// validateOptions applies the API option rules (count, uniqueness) to the
+// non-empty lines of the options field.
 func validateOptions(s string) error {
-	opts := parseOptionsText(s)
-	if len(opts) < 2 {
-		return fmt.Errorf("need at least 2 options, got %d", len(opts))
-	}
+	values := parseOptionsText(s)
 
-	if len(opts) > 30 {
-		return fmt.Errorf("maximum 30 options, got %d", len(opts))
+	opts := make([]*api.PollOption, len(values))
+	for i, v := range values {
+		opts[i] = &api.PollOption{Type: api.OptionTypeText, Value: v, Position: i}
 	}
 
-	return nil
+	return api.ValidatePollOptions(api.PollTypeMultipleChoice, opts)
 }
 
 // parseOptionsText splits text on newlines, trims whitespace, filters empty lines.
@@ -3,7 +3,11 @@ package tui
 import (
 	"os"
 
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/huh"
 	"github.com/charmbracelet/lipgloss"
+
+	"github.com/dedene/strawpoll-cli/internal/theme"
 )
 
 // StderrRenderer is a lipgloss renderer targeting stderr.
@@ -11,10 +15,132 @@ import (
 // while data goes to stdout.
 var StderrRenderer = lipgloss.NewRenderer(os.Stderr)
 
-// Shared styles for consistent look across TUI components.
+// Shared styles for consistent look across TUI components. They follow the
+// active theme; see ApplyTheme.
+var (
+	TitleStyle    lipgloss.Style
+	SubtitleStyle lipgloss.Style
+	SelectedStyle lipgloss.Style
+	ErrorStyle    lipgloss.Style
+)
+
+// Styles of the watch dashboard, which renders to stdout.
 var (
-	TitleStyle    = StderrRenderer.NewStyle().Bold(true)
-	SubtitleStyle = StderrRenderer.NewStyle().Faint(true)
-	SelectedStyle = StderrRenderer.NewStyle().Foreground(lipgloss.Color("2"))
-	ErrorStyle    = StderrRenderer.NewStyle().Foreground(lipgloss.Color("1"))
+	watchTitleStyle lipgloss.Style
+	watchDimStyle   lipgloss.Style
+	watchBarStyle   lipgloss.Style
+	watchUpStyle    lipgloss.Style
+	watchDownStyle  lipgloss.Style
+	watchWarnStyle  lipgloss.Style
 )
+
+// formPalette is the palette forms are themed with.
+var formPalette theme.Palette
+
+func init() {
+	// Assume a dark background until ApplyTheme can query the terminal.
+	applyPalettes(theme.Current().Palette(true), theme.Current().Palette(true))
+}
+
+// pendingTheme is the theme set by ApplyTheme and not yet resolved.
+var pendingTheme *theme.Theme
+
+// ApplyTheme restyles the TUI components for t, resolved for the light or
+// dark background of the terminal each renderer writes to. Detecting the
+// background queries the terminal, which can stall on terminals that do
+// not answer, so it waits until a form or the dashboard renders.
+func ApplyTheme(t theme.Theme) {
+	pendingTheme = &t
+}
+
+// resolveTheme applies the theme set by ApplyTheme, if any.
+func resolveTheme() {
+	if pendingTheme == nil {
+		return
+	}
+
+	t := *pendingTheme
+	pendingTheme = nil
+
+	applyPalettes(t.Palette(StderrRenderer.HasDarkBackground()), t.Palette(lipgloss.HasDarkBackground()))
+}
+
+// applyPalettes sets the stderr styles from p and the stdout (watch) styles
+// from w.
+func applyPalettes(p, w theme.Palette) {
+	TitleStyle = foreground(StderrRenderer.NewStyle().Bold(true), p.Header)
+	SubtitleStyle = muted(StderrRenderer.NewStyle(), p.Muted)
+	SelectedStyle = foreground(StderrRenderer.NewStyle(), p.Success)
+	ErrorStyle = foreground(StderrRenderer.NewStyle(), p.Error)
+	formPalette = p
+
+	watchTitleStyle = foreground(lipgloss.NewStyle().Bold(true), w.Header)
+	watchDimStyle = muted(lipgloss.NewStyle(), w.Muted)
+	watchBarStyle = foreground(lipgloss.NewStyle(), w.Accent)
+	watchUpStyle = foreground(lipgloss.NewStyle(), w.Success)
+	watchDownStyle = foreground(lipgloss.NewStyle(), w.Error)
+	watchWarnStyle = foreground(lipgloss.NewStyle(), w.Warning)
+}
+
+// setupForm applies the shared form options: output on stderr, the active
+// theme and accessible mode.
+func setupForm(f *huh.Form) *huh.Form {
+	resolveTheme()
+
+	return f.WithProgramOptions(tea.WithOutput(os.Stderr)).
+		WithOutput(os.Stderr).
+		WithTheme(formTheme()).
+		WithAccessible(accessible)
+}
+
+// formTheme returns the huh theme for the active palette.
+func formTheme() *huh.Theme {
+	p := formPalette
+	t := huh.ThemeBase()
+
+	t.Focused.Title = foreground(t.Focused.Title.Bold(true), p.Header)
+	t.Focused.NoteTitle = foreground(t.Focused.NoteTitle.Bold(true), p.Header)
+	t.Focused.Description = muted(t.Focused.Description, p.Muted)
+	t.Focused.Base = t.Focused.Base.BorderForeground(color(p.Accent))
+	t.Focused.SelectSelector = foreground(t.Focused.SelectSelector, p.Accent)
+	t.Focused.MultiSelectSelector = foreground(t.Focused.MultiSelectSelector, p.Accent)
+	t.Focused.SelectedOption = foreground(t.Focused.SelectedOption, p.Success)
+	t.Focused.SelectedPrefix = foreground(t.Focused.SelectedPrefix, p.Success)
+	t.Focused.FocusedButton = t.Focused.FocusedButton.Background(color(p.Accent))
+	t.Focused.TextInput.Cursor = foreground(t.Focused.TextInput.Cursor, p.Accent)
+	t.Focused.TextInput.Prompt = foreground(t.Focused.TextInput.Prompt, p.Accent)
+	t.Focused.ErrorIndicator = foreground(t.Focused.ErrorIndicator, p.Error)
+	t.Focused.ErrorMessage = foreground(t.Focused.ErrorMessage, p.Error)
+
+	t.Blurred = t.Focused
+	t.Blurred.Base = t.Blurred.Base.BorderStyle(lipgloss.HiddenBorder())
+	t.Blurred.Title = muted(t.Blurred.Title.UnsetForeground(), p.Muted)
+
+	return t
+}
+
+// color returns c as a lipgloss color; empty means no color.
+func color(c string) lipgloss.TerminalColor {
+	if c == "" {
+		return lipgloss.NoColor{}
+	}
+
+	return lipgloss.Color(c)
+}
+
+func foreground(s lipgloss.Style, c string) lipgloss.Style {
+	if c == "" {
+		return s
+	}
+
+	return s.Foreground(lipgloss.Color(c))
+}
+
+// muted colors s with c, or makes it faint when the theme has no muted color.
+func muted(s lipgloss.Style, c string) lipgloss.Style {
+	if c == "" {
+		return s.Faint(true)
+	}
+
+	return s.Foreground(lipgloss.Color(c))
+}
@@ -0,0 +1,33 @@
+package tui
+
+import (
+	"testing"
+
+	"github.com/dedene/strawpoll-cli/internal/theme"
+)
+
+func TestApplyTheme_Deferred(t *testing.T) {
+	t.Cleanup(func() { pendingTheme = nil })
+
+	hc, err := theme.Lookup("high-contrast", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	before := formPalette
+	ApplyTheme(hc)
+
+	if formPalette != before || pendingTheme == nil {
+		t.Fatal("ApplyTheme should defer restyling until output is produced")
+	}
+
+	resolveTheme()
+
+	if pendingTheme != nil {
+		t.Error("resolveTheme should clear the pending theme")
+	}
+
+	if formPalette == before {
+		t.Error("resolveTheme should apply the pending theme")
+	}
+}
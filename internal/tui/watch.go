@@ -0,0 +1,449 @@
+package tui
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/dedene/strawpoll-cli/internal/output"
+)
+
+const (
+	watchFrameInterval = 50 * time.Millisecond
+	watchMaxBackoff    = 5 * time.Minute
+)
+
+// WatchRow is one bar in the watch dashboard.
+type WatchRow struct {
+	Label   string  `json:"label"`
+	Value   int     `json:"value"`
+	Percent float64 `json:"percentage"`
+	Delta   int     `json:"delta"`
+	Detail  string  `json:"detail,omitempty"`
+}
+
+// WatchVoter is one line of the participant breakdown.
+type WatchVoter struct {
+	Name    string `json:"name"`
+	Summary string `json:"summary"`
+}
+
+// WatchSnapshot is a single refresh of a poll's results.
+type WatchSnapshot struct {
+	ID           string       `json:"id"`
+	Title        string       `json:"title"`
+	Type         string       `json:"type"`
+	Unit         string       `json:"unit"`
+	Rows         []WatchRow   `json:"options"`
+	Participants int          `json:"participants"`
+	Votes        int          `json:"votes"`
+	Voters       []WatchVoter `json:"voters,omitempty"`
+	Deadline     *time.Time   `json:"deadline,omitempty"`
+	FetchedAt    time.Time    `json:"fetched_at"`
+}
+
+// ApplyDeltas fills each row's Delta with the change since prev, matching rows by label.
+func ApplyDeltas(prev, cur *WatchSnapshot) {
+	if prev == nil {
+		return
+	}
+
+	before := make(map[string]int, len(prev.Rows))
+	for _, r := range prev.Rows {
+		before[r.Label] = r.Value
+	}
+
+	for i := range cur.Rows {
+		if v, ok := before[cur.Rows[i].Label]; ok {
+			cur.Rows[i].Delta = cur.Rows[i].Value - v
+		}
+	}
+}
+
+// FormatRemaining renders the time left until a deadline, e.g. "2h 5m".
+func FormatRemaining(d time.Duration) string {
+	return output.Remaining(d)
+}
+
+// DescribeSnapshot renders a snapshot as plain sentences for screen
+// readers: a summary line, then one line per option with its change since
+// the previous refresh.
+func DescribeSnapshot(s *WatchSnapshot) string {
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "%s, updated %s: %d votes from %d participants",
+		s.Title, output.Times().ClockSeconds(s.FetchedAt), s.Votes, s.Participants)
+
+	if s.Deadline != nil {
+		b.WriteString(", " + output.Closes(*s.Deadline, time.Now()))
+	}
+
+	b.WriteString(".\n")
+
+	for _, r := range s.Rows {
+		value := fmt.Sprintf("%d %s", r.Value, s.Unit)
+		if s.Unit == "availability" {
+			value = fmt.Sprintf("%d available", r.Value)
+		}
+
+		fmt.Fprintf(&b, "%s: %s, %.1f percent", r.Label, value, r.Percent)
+
+		switch {
+		case r.Delta > 0:
+			fmt.Fprintf(&b, ", up %d", r.Delta)
+		case r.Delta < 0:
+			fmt.Fprintf(&b, ", down %d", -r.Delta)
+		}
+
+		if r.Detail != "" {
+			fmt.Fprintf(&b, " (%s)", r.Detail)
+		}
+
+		b.WriteString(".\n")
+	}
+
+	return b.String()
+}
+
+// WatchFetchFunc fetches a fresh snapshot.
+type WatchFetchFunc func(ctx context.Context) (*WatchSnapshot, error)
+
+// RunWatch runs the full-screen results dashboard until the user quits.
+// fetch is called every interval; failures back off exponentially up to 5 minutes.
+func RunWatch(ctx context.Context, fetch WatchFetchFunc, interval time.Duration) error {
+	resolveTheme()
+
+	m := newWatchModel(ctx, fetch, interval)
+
+	_, err := tea.NewProgram(m, tea.WithAltScreen(), tea.WithContext(ctx)).Run()
+	if err != nil && ctx.Err() != nil {
+		return nil
+	}
+
+	return err
+}
+
+type (
+	watchResultMsg struct {
+		seq  int
+		snap *WatchSnapshot
+		err  error
+	}
+	watchFetchMsg struct{ seq int }
+	watchFrameMsg struct{}
+	watchClockMsg struct{}
+)
+
+type watchModel struct {
+	ctx      context.Context
+	fetch    WatchFetchFunc
+	interval time.Duration
+	backoff  time.Duration
+	seq      int
+
+	snap *WatchSnapshot
+	err  error
+
+	bars      map[string]float64 // animated bar fill per label, 0..1
+	animating bool
+
+	paused      bool
+	showVoters  bool
+	sortByValue bool
+
+	width, height int
+}
+
+func newWatchModel(ctx context.Context, fetch WatchFetchFunc, interval time.Duration) *watchModel {
+	return &watchModel{
+		ctx:         ctx,
+		fetch:       fetch,
+		interval:    interval,
+		bars:        make(map[string]float64),
+		sortByValue: true,
+		width:       80,
+	}
+}
+
+func (m *watchModel) Init() tea.Cmd {
+	return tea.Batch(m.fetchNow(), clockTick())
+}
+
+// fetchNow fetches immediately. The result carries the current sequence
+// number so only the latest fetch loop schedules the next refresh.
+func (m *watchModel) fetchNow() tea.Cmd {
+	seq := m.seq
+
+	return func() tea.Msg {
+		snap, err := m.fetch(m.ctx)
+
+		return watchResultMsg{seq: seq, snap: snap, err: err}
+	}
+}
+
+func (m *watchModel) scheduleFetch(after time.Duration) tea.Cmd {
+	seq := m.seq
+
+	return tea.Tick(after, func(time.Time) tea.Msg { return watchFetchMsg{seq: seq} })
+}
+
+func clockTick() tea.Cmd {
+	return tea.Tick(time.Second, func(time.Time) tea.Msg { return watchClockMsg{} })
+}
+
+func frameTick() tea.Cmd {
+	return tea.Tick(watchFrameInterval, func(time.Time) tea.Msg { return watchFrameMsg{} })
+}
+
+func (m *watchModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.WindowSizeMsg:
+		m.width, m.height = msg.Width, msg.Height
+
+		return m, nil
+
+	case tea.KeyMsg:
+		switch msg.String() {
+		case "q", "esc", "ctrl+c":
+			return m, tea.Quit
+		case "p":
+			m.showVoters = !m.showVoters
+		case "s":
+			m.sortByValue = !m.sortByValue
+		case " ":
+			m.paused = !m.paused
+		case "r":
+			m.seq++
+
+			return m, m.fetchNow()
+		}
+
+		return m, nil
+
+	case watchFetchMsg:
+		if msg.seq != m.seq {
+			return m, nil
+		}
+
+		if m.paused {
+			return m, m.scheduleFetch(m.interval)
+		}
+
+		return m, m.fetchNow()
+
+	case watchResultMsg:
+		return m, m.applyResult(msg)
+
+	case watchFrameMsg:
+		if m.stepBars() {
+			return m, frameTick()
+		}
+
+		m.animating = false
+
+		return m, nil
+
+	case watchClockMsg:
+		return m, clockTick()
+	}
+
+	return m, nil
+}
+
+func (m *watchModel) applyResult(msg watchResultMsg) tea.Cmd {
+	current := msg.seq == m.seq
+
+	if msg.err != nil {
+		m.err = msg.err
+		if !current {
+			return nil
+		}
+
+		m.backoff = min(max(m.backoff*2, m.interval), watchMaxBackoff)
+
+		return m.scheduleFetch(m.backoff)
+	}
+
+	ApplyDeltas(m.snap, msg.snap)
+	m.snap = msg.snap
+	m.err = nil
+	m.backoff = 0
+
+	var cmds []tea.Cmd
+	if current {
+		cmds = append(cmds, m.scheduleFetch(m.interval))
+	}
+
+	if !m.animating {
+		m.animating = true
+		cmds = append(cmds, frameTick())
+	}
+
+	return tea.Batch(cmds...)
+}
+
+// stepBars eases every bar toward its target and reports whether any still move.
+func (m *watchModel) stepBars() bool {
+	if m.snap == nil {
+		return false
+	}
+
+	peak := peakValue(m.snap.Rows)
+	moving := false
+
+	for _, r := range m.snap.Rows {
+		target := 0.0
+		if peak > 0 {
+			target = float64(r.Value) / float64(peak)
+		}
+
+		cur := m.bars[r.Label]
+		if math.Abs(target-cur) < 0.005 {
+			m.bars[r.Label] = target
+
+			continue
+		}
+
+		m.bars[r.Label] = cur + (target-cur)*0.3
+		moving = true
+	}
+
+	return moving
+}
+
+func peakValue(rows []WatchRow) int {
+	peak := 0
+	for _, r := range rows {
+		peak = max(peak, r.Value)
+	}
+
+	return peak
+}
+
+func (m *watchModel) View() string {
+	if m.snap == nil {
+		if m.err != nil {
+			return watchWarnStyle.Render(fmt.Sprintf("Error: %v (retrying in %s)", m.err, m.backoff)) + "\n"
+		}
+
+		return "Loading results...\n"
+	}
+
+	var b strings.Builder
+
+	s := m.snap
+
+	b.WriteString(watchTitleStyle.Render(s.Title))
+	b.WriteString(watchDimStyle.Render("  " + s.ID))
+	b.WriteString("\n")
+	b.WriteString(m.statusLine())
+	b.WriteString("\n\n")
+
+	rows := slices.Clone(s.Rows)
+	if m.sortByValue {
+		sort.SliceStable(rows, func(i, j int) bool { return rows[i].Value > rows[j].Value })
+	}
+
+	labelWidth := 0
+	for _, r := range rows {
+		labelWidth = max(labelWidth, lipgloss.Width(r.Label))
+	}
+
+	labelWidth = min(labelWidth, max(m.width/3, 10))
+	barWidth := max(m.width-labelWidth-28, 10)
+
+	for _, r := range rows {
+		label := truncate(r.Label, labelWidth)
+		fill := int(math.Round(m.bars[r.Label] * float64(barWidth)))
+		bar := watchBarStyle.Render(strings.Repeat("█", fill)) + watchDimStyle.Render(strings.Repeat("░", barWidth-fill))
+
+		fmt.Fprintf(&b, "%-*s  %s %5d %5.1f%%", labelWidth, label, bar, r.Value, r.Percent)
+
+		switch {
+		case r.Delta > 0:
+			b.WriteString(watchUpStyle.Render(fmt.Sprintf("  +%d", r.Delta)))
+		case r.Delta < 0:
+			b.WriteString(watchDownStyle.Render(fmt.Sprintf("  %d", r.Delta)))
+		}
+
+		if r.Detail != "" {
+			b.WriteString(watchDimStyle.Render("  " + r.Detail))
+		}
+
+		b.WriteString("\n")
+	}
+
+	if m.showVoters {
+		b.WriteString("\n")
+		b.WriteString(watchTitleStyle.Render("Participants"))
+		b.WriteString("\n")
+
+		if len(s.Voters) == 0 {
+			b.WriteString(watchDimStyle.Render("  none visible"))
+			b.WriteString("\n")
+		}
+
+		for _, v := range s.Voters {
+			fmt.Fprintf(&b, "  %s  %s\n", v.Name, watchDimStyle.Render(v.Summary))
+		}
+	}
+
+	if m.err != nil {
+		b.WriteString("\n")
+		b.WriteString(watchWarnStyle.Render(fmt.Sprintf("Refresh failed: %v (retrying in %s)", m.err, m.backoff)))
+		b.WriteString("\n")
+	}
+
+	b.WriteString("\n")
+	b.WriteString(watchDimStyle.Render("p participants · s sort · space pause · r refresh · q quit"))
+	b.WriteString("\n")
+
+	return b.String()
+}
+
+func (m *watchModel) statusLine() string {
+	s := m.snap
+	parts := []string{
+		fmt.Sprintf("Participants: %d", s.Participants),
+		fmt.Sprintf("Votes: %d", s.Votes),
+	}
+
+	if s.Deadline != nil {
+		parts = append(parts, "Closes in: "+FormatRemaining(time.Until(*s.Deadline)))
+	}
+
+	parts = append(parts, fmt.Sprintf("Updated %s (every %s)", output.Times().ClockSeconds(s.FetchedAt), m.interval))
+
+	if m.sortByValue {
+		parts = append(parts, "sorted by "+s.Unit)
+	} else {
+		parts = append(parts, "original order")
+	}
+
+	line := strings.Join(parts, "   ")
+	if m.paused {
+		line += "   " + watchWarnStyle.Render("[PAUSED]")
+	}
+
+	return line
+}
+
+func truncate(s string, width int) string {
+	r := []rune(s)
+	if len(r) <= width {
+		return s
+	}
+
+	if width <= 1 {
+		return string(r[:width])
+	}
+
+	return string(r[:width-1]) + "…"
+}
@@ -0,0 +1,122 @@
+package tui
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func TestApplyDeltas(t *testing.T) {
+	prev := &WatchSnapshot{Rows: []WatchRow{{Label: "A", Value: 3}, {Label: "B", Value: 5}}}
+	cur := &WatchSnapshot{Rows: []WatchRow{{Label: "A", Value: 5}, {Label: "B", Value: 4}, {Label: "C", Value: 1}}}
+
+	ApplyDeltas(prev, cur)
+
+	want := []int{2, -1, 0}
+	for i, r := range cur.Rows {
+		if r.Delta != want[i] {
+			t.Errorf("row %s delta = %d, want %d", r.Label, r.Delta, want[i])
+		}
+	}
+}
+
+func TestFormatRemaining(t *testing.T) {
+	tests := []struct {
+		d    time.Duration
+		want string
+	}{
+		{-time.Second, "closed"},
+		{45 * time.Second, "45s"},
+		{3*time.Minute + 5*time.Second, "3m 5s"},
+		{2*time.Hour + 5*time.Minute, "2h 5m"},
+		{50 * time.Hour, "2d 2h"},
+	}
+
+	for _, tt := range tests {
+		if got := FormatRemaining(tt.d); got != tt.want {
+			t.Errorf("FormatRemaining(%v) = %q, want %q", tt.d, got, tt.want)
+		}
+	}
+}
+
+func TestWatchModel_BackoffAndRecovery(t *testing.T) {
+	m := newWatchModel(context.Background(), nil, 2*time.Second)
+
+	m.applyResult(watchResultMsg{err: errors.New("boom")})
+	if m.backoff != 2*time.Second {
+		t.Errorf("first backoff = %v, want 2s", m.backoff)
+	}
+
+	m.applyResult(watchResultMsg{err: errors.New("boom")})
+	if m.backoff != 4*time.Second {
+		t.Errorf("second backoff = %v, want 4s", m.backoff)
+	}
+
+	m.applyResult(watchResultMsg{snap: &WatchSnapshot{Title: "T"}})
+	if m.backoff != 0 || m.err != nil {
+		t.Errorf("after success backoff = %v, err = %v; want reset", m.backoff, m.err)
+	}
+}
+
+func TestWatchModel_Keys(t *testing.T) {
+	m := newWatchModel(context.Background(), nil, time.Second)
+	m.applyResult(watchResultMsg{snap: &WatchSnapshot{
+		Title:  "Lunch",
+		Unit:   "votes",
+		Rows:   []WatchRow{{Label: "Pizza", Value: 1}, {Label: "Sushi", Value: 3}},
+		Voters: []WatchVoter{{Name: "Ann", Summary: "Sushi"}},
+	}})
+
+	press := func(k string) {
+		msg := tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(k)}
+		if k == " " {
+			msg = tea.KeyMsg{Type: tea.KeySpace, Runes: []rune(k)}
+		}
+
+		m.Update(msg)
+	}
+
+	if strings.Index(m.View(), "Sushi") > strings.Index(m.View(), "Pizza") {
+		t.Error("default view should sort the leading option first")
+	}
+
+	press("s")
+	if strings.Index(m.View(), "Pizza") > strings.Index(m.View(), "Sushi") {
+		t.Error("after 's' options should be in original order")
+	}
+
+	press("p")
+	if !strings.Contains(m.View(), "Ann") {
+		t.Error("after 'p' participant breakdown should be shown")
+	}
+
+	press(" ")
+	if !strings.Contains(m.View(), "PAUSED") {
+		t.Error("after space the view should show paused state")
+	}
+}
+
+func TestDescribeSnapshot(t *testing.T) {
+	snap := &WatchSnapshot{
+		Title:        "Lunch?",
+		Unit:         "votes",
+		Votes:        5,
+		Participants: 4,
+		FetchedAt:    time.Date(2025, 6, 1, 10, 4, 5, 0, time.UTC),
+		Rows: []WatchRow{
+			{Label: "Pizza", Value: 3, Percent: 60, Delta: 1},
+			{Label: "Sushi", Value: 2, Percent: 40},
+		},
+	}
+
+	want := "Lunch?, updated 10:04:05: 5 votes from 4 participants.\n" +
+		"Pizza: 3 votes, 60.0 percent, up 1.\n" +
+		"Sushi: 2 votes, 40.0 percent.\n"
+	if got := DescribeSnapshot(snap); got != want {
+		t.Errorf("DescribeSnapshot() = %q, want %q", got, want)
+	}
+}